@@ -0,0 +1,208 @@
+package edsger
+
+import (
+	"errors"
+	"maps"
+	"math/bits"
+)
+
+// Result of an all-pairs shortest paths computation. Dist and Next are indexed
+// by the node indices of the graph, i.e. Nodes[i] is the node with index i.
+// Unreachable pairs have a distance of MaxValue[N]() and a next hop of -1.
+type AllPairsShortestPaths[T comparable, N Number] struct {
+	Nodes []T
+	Dist  [][]N
+	Next  [][]int
+
+	index map[T]int
+}
+
+func newAllPairsShortestPaths[T comparable, N Number](g *Graph[T, N]) *AllPairsShortestPaths[T, N] {
+	n := len(g.nodes)
+	maxW := MaxValue[N]()
+	res := &AllPairsShortestPaths[T, N]{
+		Nodes: g.NodesList(),
+		Dist:  make([][]N, n),
+		Next:  make([][]int, n),
+		index: maps.Clone(g.nodes),
+	}
+	for i := range n {
+		res.Dist[i] = make([]N, n)
+		res.Next[i] = make([]int, n)
+		for j := range n {
+			res.Dist[i][j] = maxW
+			res.Next[i][j] = -1
+		}
+		res.Dist[i][i] = 0
+		res.Next[i][i] = i
+	}
+	return res
+}
+
+// Returns the distance between source and dest, and false if dest is not
+// reachable from source.
+func (ap *AllPairsShortestPaths[T, N]) Distance(source, dest T) (N, bool) {
+	i, ok := ap.index[source]
+	if !ok {
+		return 0, false
+	}
+	j, ok := ap.index[dest]
+	if !ok || ap.Next[i][j] < 0 {
+		return 0, false
+	}
+	return ap.Dist[i][j], true
+}
+
+// Reconstructs the shortest path between source and dest. Returns nil if dest
+// is not reachable from source.
+func (ap *AllPairsShortestPaths[T, N]) Path(source, dest T) ([]T, N) {
+	i, ok := ap.index[source]
+	if !ok {
+		return nil, 0
+	}
+	j, ok := ap.index[dest]
+	if !ok || ap.Next[i][j] < 0 {
+		return nil, 0
+	}
+
+	path := []T{source}
+	for k := i; k != j; {
+		k = ap.Next[k][j]
+		path = append(path, ap.Nodes[k])
+	}
+	return path, ap.Dist[i][j]
+}
+
+// Computes all-pairs shortest paths. Uses the Floyd-Warshall algorithm for
+// dense graphs and Johnson's algorithm for sparse graphs. Edges may have
+// negative weights. Returns a *NegativeCycleError if the graph contains a
+// negative cycle.
+func (g *Graph[T, N]) AllPairsShortestPaths() (*AllPairsShortestPaths[T, N], error) {
+	n, m := len(g.nodes), g.NumberOfEdges()
+	if m*bits.Len(uint(n)) >= n*n {
+		return g.FloydWarshall()
+	}
+	return g.Johnson()
+}
+
+// Implementation of the Floyd-Warshall all-pairs shortest paths algorithm.
+// Returns a *NegativeCycleError if the graph contains a negative cycle.
+func (g *Graph[T, N]) FloydWarshall() (*AllPairsShortestPaths[T, N], error) {
+	res := newAllPairsShortestPaths(g)
+	maxW := MaxValue[N]()
+	for src, edges := range g.edges {
+		i := g.nodes[src]
+		for _, e := range edges {
+			j := g.nodes[e.Node]
+			if e.Weight < res.Dist[i][j] {
+				res.Dist[i][j] = e.Weight
+				res.Next[i][j] = j
+			}
+		}
+	}
+
+	n := len(res.Nodes)
+	for k := range n {
+		dk := res.Dist[k]
+		for i := range n {
+			di := res.Dist[i]
+			if res.Next[i][k] < 0 {
+				continue
+			}
+			for j := range n {
+				if res.Next[k][j] < 0 {
+					continue
+				}
+				// We prevent here any integer overflow
+				if dk[j] == maxW || di[k] == maxW {
+					continue
+				}
+				if alt := di[k] + dk[j]; alt < di[j] {
+					di[j] = alt
+					res.Next[i][j] = res.Next[i][k]
+				}
+			}
+			if di[i] < 0 {
+				return nil, g.negativeCycleError(res.Nodes[i])
+			}
+		}
+	}
+	return res, nil
+}
+
+// Returns the negative cycle reachable from node
+func (g *Graph[T, N]) negativeCycleError(node T) error {
+	_, _, err := g.bellmanFord(node)
+	if err == nil {
+		return ErrNegativeCycle
+	}
+	return err
+}
+
+// Implementation of Johnson's all-pairs shortest paths algorithm. Edge weights
+// are made non-negative using potentials computed by the Bellman-Ford
+// algorithm, before running Dijkstra's algorithm from each node. Returns a
+// *NegativeCycleError if the graph contains a negative cycle.
+func (g *Graph[T, N]) Johnson() (*AllPairsShortestPaths[T, N], error) {
+	// Equivalent to running Bellman-Ford from a virtual node connected to all
+	// other nodes with zero-weight edges
+	h, _, err := g.bellmanFord(g.NodesList()...)
+	if err != nil {
+		return nil, err
+	}
+
+	reweighted := &Graph[T, N]{
+		nodes:    g.nodes,
+		edges:    make(map[T][]*NodeWeight[T, N], len(g.edges)),
+		directed: g.directed,
+	}
+	for src, edges := range g.edges {
+		redges := make([]*NodeWeight[T, N], len(edges))
+		for i, e := range edges {
+			redges[i] = &NodeWeight[T, N]{
+				Node:   e.Node,
+				Weight: e.Weight + h[src] - h[e.Node],
+			}
+		}
+		reweighted.edges[src] = redges
+	}
+
+	res := newAllPairsShortestPaths(g)
+	for i, src := range res.Nodes {
+		prev, dist, err := reweighted.sourceShortestPathMap(src, false, nil)
+		if errors.Is(err, ErrNegativeWeight) {
+			// Can only happen due to floating-point rounding errors
+			return g.FloydWarshall()
+		} else if err != nil {
+			return nil, err
+		}
+
+		for dst, d := range dist {
+			j := g.nodes[dst]
+			res.Dist[i][j] = d - h[src] + h[dst]
+		}
+
+		// Compute the next hops by walking the shortest path tree
+		next := res.Next[i]
+		var chain []int
+		for dst := range dist {
+			chain = chain[:0]
+			v := dst
+			for next[g.nodes[v]] < 0 {
+				u := prev[v][0]
+				chain = append(chain, g.nodes[v])
+				if u == src {
+					next[g.nodes[v]] = g.nodes[v]
+					chain = chain[:len(chain)-1]
+					break
+				}
+				v = u
+			}
+			hop := next[g.nodes[v]]
+			for _, c := range chain {
+				next[c] = hop
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,110 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func validateAllPairsShortestPaths(t *testing.T, g *Graph[int, int], ap *AllPairsShortestPaths[int, int]) {
+	for src := range g.Nodes() {
+		for dst := range g.Nodes() {
+			_, expected, err := g.BellmanFordShortestPath(src, dst)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+
+			path, total := ap.Path(src, dst)
+			if path == nil {
+				if g.HasSimplePath(src, dst) {
+					t.Fatalf("Missing path from %d to %d", src, dst)
+				}
+				continue
+			}
+			if total != expected {
+				t.Fatalf("Invalid path from %d to %d: %v %d (expected %d)", src, dst, path, total, expected)
+			}
+			if d, ok := ap.Distance(src, dst); !ok || d != total {
+				t.Fatalf("Invalid distance from %d to %d: %d", src, dst, d)
+			}
+			validatePath(t, g, path, total)
+		}
+	}
+}
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	graphs := map[string]*Graph[int, int]{
+		"Wikipedia":  WikipediaGraph(),
+		"Hackerrank": HackerrankGraph(),
+		"NoPath":     NoPathGraph(),
+		"Random":     RandomGraph(true, 30, 100, 1, 10, 1),
+		"Negative":   RandomGraph(true, 30, 100, 0, 10, 2),
+	}
+
+	// Introduce negative weights without creating a negative cycle by
+	// applying node potentials to non-negative weights
+	negative := graphs["Negative"]
+	for e := range negative.Edges() {
+		negative.UpdateEdge(e.From, e.To, e.Weight+e.From%7-e.To%7)
+	}
+
+	for name, g := range graphs {
+		t.Run(name+"/FloydWarshall", func(t *testing.T) {
+			ap, err := g.FloydWarshall()
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			validateAllPairsShortestPaths(t, g, ap)
+		})
+		t.Run(name+"/Johnson", func(t *testing.T) {
+			ap, err := g.Johnson()
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			validateAllPairsShortestPaths(t, g, ap)
+		})
+	}
+}
+
+func TestAllPairsShortestPathsNegativeCycle(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, -1)
+	g.AddEdge(2, 1, -1)
+	g.AddEdge(2, 3, 1)
+
+	if _, err := g.FloydWarshall(); !errors.Is(err, ErrNegativeCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, err := g.Johnson(); !errors.Is(err, ErrNegativeCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, err := g.AllPairsShortestPaths(); !errors.Is(err, ErrNegativeCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestAllPairsShortestPathsAfterRemoveNode(t *testing.T) {
+	g := NewDirectedGraph[string, int]()
+	for _, n := range []string{"a", "b", "c"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 5)
+
+	ap, err := g.AllPairsShortestPaths()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Removing a node reindexes the graph but not earlier results
+	g.RemoveNode("a")
+	if d, ok := ap.Distance("b", "c"); !ok || d != 5 {
+		t.Fatalf("Invalid distance: %d %v", d, ok)
+	}
+	if path, cost := ap.Path("b", "c"); !slices.Equal(path, []string{"b", "c"}) || cost != 5 {
+		t.Fatalf("Invalid path: %v %d", path, cost)
+	}
+}
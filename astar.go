@@ -0,0 +1,118 @@
+package edsger
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+)
+
+// Implementation of the A* search algorithm. The heuristic h returns an
+// estimate of the distance from a node to dest. It must be admissible, i.e.
+// never overestimate the actual distance, for the returned path to be a
+// shortest path.
+func (g *Graph[T, N]) AStarShortestPath(source, dest T, h func(T) N) ([]T, N) {
+	return must2(g.TryAStarShortestPath(source, dest, h))
+}
+
+// Same as AStarShortestPath but returns an error instead of panicking
+func (g *Graph[T, N]) TryAStarShortestPath(source, dest T, h func(T) N) ([]T, N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, 0, err
+	}
+
+	prev := make(map[T][]T)
+	dist := map[T]N{source: 0}
+
+	q := newPriorityQueue[T, N](0)
+	q.Append(source, h(source))
+	for q.Len() > 0 {
+		u := heap.Pop(q).(*priorityItem[T, N])
+		if u.node == dest {
+			path, _ := pathFromShortestPathMap(dest, prev, dist[dest])
+			return path, dist[dest], nil
+		}
+
+		du := dist[u.node]
+		for _, v := range g.Neighbors(u.node) {
+			if v.Weight < 0 {
+				return nil, 0, fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, u.node, v.Node)
+			}
+
+			alt := du + v.Weight
+			if dv, ok := dist[v.Node]; ok && alt >= dv {
+				continue
+			}
+			dist[v.Node] = alt
+			prev[v.Node] = []T{u.node}
+
+			// Nodes which were already expanded are reinserted in the queue
+			// since the heuristic is not necessarily consistent
+			pi, ok := q.m[v.Node]
+			if !ok || pi.index < 0 {
+				heap.Push(q, v.Node)
+				pi = q.m[v.Node]
+			}
+			q.update(pi, alt+h(v.Node))
+		}
+	}
+
+	// No path was found
+	return nil, 0, nil
+}
+
+// Node types exposing cartesian coordinates
+type CartesianNode interface {
+	comparable
+	Coordinates() (x, y float64)
+}
+
+// Node types exposing geographic coordinates in degrees
+type GeographicNode interface {
+	comparable
+	LatLon() (lat, lon float64)
+}
+
+// Returns a heuristic computing the Euclidean distance to dest. Admissible
+// if edge weights are not smaller than the Euclidean distance between nodes.
+func EuclideanHeuristic[T CartesianNode, N Number](dest T) func(T) N {
+	dx, dy := dest.Coordinates()
+	return func(n T) N {
+		x, y := n.Coordinates()
+		return N(math.Hypot(x-dx, y-dy))
+	}
+}
+
+// Returns a heuristic computing the Manhattan distance to dest. Admissible
+// if edge weights are not smaller than the Manhattan distance between nodes,
+// e.g. on grids without diagonal moves.
+func ManhattanHeuristic[T CartesianNode, N Number](dest T) func(T) N {
+	dx, dy := dest.Coordinates()
+	return func(n T) N {
+		x, y := n.Coordinates()
+		return N(math.Abs(x-dx) + math.Abs(y-dy))
+	}
+}
+
+// Mean Earth radius in meters
+const earthRadius = 6371008.8
+
+// Returns a heuristic computing the great-circle distance in meters to dest
+// using the haversine formula. Admissible if edge weights are not smaller than
+// the great-circle distance between nodes.
+func HaversineHeuristic[T GeographicNode, N Number](dest T) func(T) N {
+	dlat, dlon := dest.LatLon()
+	return func(n T) N {
+		lat, lon := n.LatLon()
+		return N(haversine(lat, lon, dlat, dlon))
+	}
+}
+
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	phi1, phi2 := toRad(lat1), toRad(lat2)
+	dphi, dlambda := toRad(lat2-lat1), toRad(lon2-lon1)
+
+	a := math.Sin(dphi/2)*math.Sin(dphi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(dlambda/2)*math.Sin(dlambda/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
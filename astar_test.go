@@ -0,0 +1,105 @@
+package edsger
+
+import (
+	"math"
+	"testing"
+)
+
+type gridPoint struct {
+	X, Y int
+}
+
+func (p gridPoint) Coordinates() (float64, float64) {
+	return float64(p.X), float64(p.Y)
+}
+
+type geoPoint struct {
+	Lat, Lon float64
+}
+
+func (p geoPoint) LatLon() (float64, float64) {
+	return p.Lat, p.Lon
+}
+
+// Grid graph with n x n nodes with some random obstacles
+func GridGraph(n int) *Graph[gridPoint, int] {
+	g := NewUndirectedGraph[gridPoint, int]()
+	blocked := func(x, y int) bool {
+		return x%4 == 2 && y != (x*7)%n
+	}
+	for x := range n {
+		for y := range n {
+			if !blocked(x, y) {
+				g.AddNode(gridPoint{x, y})
+			}
+		}
+	}
+	for x := range n {
+		for y := range n {
+			p := gridPoint{x, y}
+			if !g.HasNode(p) {
+				continue
+			}
+			for _, q := range []gridPoint{{x + 1, y}, {x, y + 1}} {
+				if g.HasNode(q) {
+					g.AddEdge(p, q, 1+(x*y)%3)
+				}
+			}
+		}
+	}
+	return g
+}
+
+func TestAStarGrid(t *testing.T) {
+	g := GridGraph(20)
+	source, dest := gridPoint{0, 0}, gridPoint{19, 19}
+
+	expectedPath, expected := g.DijkstraShortestPath(source, dest)
+	t.Log(expectedPath, expected)
+
+	heuristics := map[string]func(gridPoint) int{
+		"Zero":      func(gridPoint) int { return 0 },
+		"Euclidean": EuclideanHeuristic[gridPoint, int](dest),
+		"Manhattan": ManhattanHeuristic[gridPoint, int](dest),
+	}
+	for name, h := range heuristics {
+		path, total := g.AStarShortestPath(source, dest, h)
+		if total != expected {
+			t.Fatalf("%s: invalid path: %v %d", name, path, total)
+		}
+		if path[0] != source || path[len(path)-1] != dest {
+			t.Fatalf("%s: invalid path: %v", name, path)
+		}
+	}
+}
+
+func TestAStarWikipediaGraph(t *testing.T) {
+	g := WikipediaGraph()
+	for src := range g.Nodes() {
+		for dst := range g.Nodes() {
+			_, expected := g.DijkstraShortestPath(src, dst)
+			_, total := g.AStarShortestPath(src, dst, func(int) int { return 0 })
+			if total != expected {
+				t.Fatalf("Invalid path from %d to %d", src, dst)
+			}
+		}
+	}
+}
+
+func TestAStarNoPath(t *testing.T) {
+	g := NoPathGraph()
+	path, total := g.AStarShortestPath(1, 5, func(int) int { return 0 })
+	if len(path) != 0 || total != 0 {
+		t.Fatal("Invalid path")
+	}
+}
+
+func TestHaversineHeuristic(t *testing.T) {
+	paris := geoPoint{48.8566, 2.3522}
+	london := geoPoint{51.5074, -0.1278}
+
+	d := HaversineHeuristic[geoPoint, float64](london)(paris)
+	if math.Abs(d-343.5e3) > 1e3 {
+		t.Fatal("Invalid distance:", d)
+	}
+}
@@ -0,0 +1,103 @@
+package edsger
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Error returned when a negative cycle is reachable from the source node.
+// Cycle starts and ends with the same node.
+type NegativeCycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNegativeCycle, e.Cycle)
+}
+
+// Makes errors.Is(err, ErrNegativeCycle) work
+func (e *NegativeCycleError[T]) Is(target error) bool {
+	return target == ErrNegativeCycle
+}
+
+// Implementation of the Bellman-Ford algorithm. Returns the distances and
+// predecessors of all nodes reachable from one of the sources.
+func (g *Graph[T, N]) bellmanFord(sources ...T) (map[T]N, map[T]T, error) {
+	dist := make(map[T]N, len(g.nodes))
+	prev := make(map[T]T, len(g.nodes))
+	for _, source := range sources {
+		dist[source] = 0
+	}
+
+	relax := func() (T, bool) {
+		var last T
+		updated := false
+		for u, edges := range g.edges {
+			du, ok := dist[u]
+			if !ok {
+				continue
+			}
+			for _, e := range edges {
+				alt := du + e.Weight
+				if dv, ok := dist[e.Node]; !ok || alt < dv {
+					dist[e.Node] = alt
+					prev[e.Node] = u
+					last = e.Node
+					updated = true
+				}
+			}
+		}
+		return last, updated
+	}
+
+	for range len(g.nodes) - 1 {
+		if _, updated := relax(); !updated {
+			return dist, prev, nil
+		}
+	}
+
+	last, updated := relax()
+	if !updated {
+		return dist, prev, nil
+	}
+
+	// Walk back enough steps to make sure we are on the cycle
+	for range len(g.nodes) {
+		last = prev[last]
+	}
+	cycle := []T{last}
+	for v := prev[last]; v != last; v = prev[v] {
+		cycle = append(cycle, v)
+	}
+	cycle = append(cycle, last)
+	slices.Reverse(cycle)
+	return nil, nil, &NegativeCycleError[T]{Cycle: cycle}
+}
+
+// Shortest path based on the Bellman-Ford algorithm. Contrary to
+// DijkstraShortestPath, edges may have negative weights. Returns a
+// *NegativeCycleError if a negative cycle is reachable from source.
+func (g *Graph[T, N]) BellmanFordShortestPath(source, dest T) ([]T, N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, 0, err
+	}
+
+	dist, prev, err := g.bellmanFord(source)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	d, ok := dist[dest]
+	if !ok {
+		// No path was found
+		return nil, 0, nil
+	}
+
+	path := []T{dest}
+	for v := dest; v != source; {
+		v = prev[v]
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	return path, d, nil
+}
@@ -0,0 +1,95 @@
+package edsger
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBellmanFordWikipediaGraph(t *testing.T) {
+	g := WikipediaGraph()
+	for src := range g.Nodes() {
+		for dst := range g.Nodes() {
+			_, expected := g.DijkstraShortestPath(src, dst)
+			path, total, err := g.BellmanFordShortestPath(src, dst)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			if total != expected {
+				t.Fatalf("Invalid path from %d to %d: %v %d", src, dst, path, total)
+			}
+		}
+	}
+}
+
+func TestBellmanFordNoPath(t *testing.T) {
+	g := NoPathGraph()
+	path, total, err := g.BellmanFordShortestPath(1, 5)
+	if err != nil || len(path) != 0 || total != 0 {
+		t.Fatal("Invalid path")
+	}
+}
+
+func TestBellmanFordNegativeWeights(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 2, 5)
+	g.AddEdge(1, 3, 3)
+	g.AddEdge(2, 1, -3)
+
+	path, total, err := g.BellmanFordShortestPath(0, 3)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if total != 5 || len(path) != 4 {
+		t.Fatal("Invalid path:", path, total)
+	}
+
+	// Negative cycle not reachable from the source
+	g.AddNode(4)
+	g.AddEdge(4, 4, -1)
+	if _, _, err := g.BellmanFordShortestPath(0, 3); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 5 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, -2)
+	g.AddEdge(3, 1, -1)
+	g.AddEdge(3, 4, 1)
+
+	_, _, err := g.BellmanFordShortestPath(0, 4)
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	var cerr *NegativeCycleError[int]
+	if !errors.As(err, &cerr) {
+		t.Fatal("Unexpected error:", err)
+	}
+	t.Log(cerr.Cycle)
+
+	cycle := cerr.Cycle
+	if len(cycle) != 4 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatal("Invalid cycle:", cycle)
+	}
+	total := 0
+	for i := range len(cycle) - 1 {
+		w, ok := g.GetEdge(cycle[i], cycle[i+1])
+		if !ok {
+			t.Fatal("Invalid cycle:", cycle)
+		}
+		total += w
+	}
+	if total >= 0 {
+		t.Fatal("Cycle is not negative:", cycle)
+	}
+}
@@ -0,0 +1,147 @@
+package edsger
+
+// Result of the Hopcroft-Tarjan depth-first search
+type biconnectivity[T comparable, N Number] struct {
+	articulationPoints []T
+	bridges            []*WeightedEdge[T, N]
+	components         [][]T
+}
+
+// Iterative implementation of the Hopcroft-Tarjan algorithm computing
+// articulation points, bridges and biconnected components in a single DFS
+func (g *Graph[T, N]) hopcroftTarjan() (*biconnectivity[T, N], error) {
+	if g.directed {
+		return nil, ErrDirected
+	}
+
+	type frame struct {
+		node     T
+		edge     int
+		children int
+	}
+
+	res := &biconnectivity[T, N]{}
+	disc := make(map[T]int, len(g.nodes))
+	low := make(map[T]int, len(g.nodes))
+	isArticulation := make(map[T]bool)
+	var edgeStack [][2]T
+
+	for _, root := range g.NodesList() {
+		if _, ok := disc[root]; ok {
+			continue
+		}
+
+		disc[root], low[root] = len(disc), len(disc)
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			u := top.node
+			if top.edge < len(g.edges[u]) {
+				v := g.edges[u][top.edge].Node
+				top.edge++
+
+				if dv, ok := disc[v]; !ok {
+					// Tree edge
+					top.children++
+					disc[v], low[v] = len(disc), len(disc)
+					edgeStack = append(edgeStack, [2]T{u, v})
+					callStack = append(callStack, frame{node: v})
+				} else if dv < disc[u] && (len(callStack) < 2 || callStack[len(callStack)-2].node != v) {
+					// Back edge to an ancestor other than the parent
+					low[u] = min(low[u], dv)
+					edgeStack = append(edgeStack, [2]T{u, v})
+				}
+				continue
+			}
+
+			// All neighbors of u have been visited
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) == 0 {
+				if top.children > 1 {
+					res.articulationPoints = append(res.articulationPoints, u)
+				}
+				continue
+			}
+
+			p := callStack[len(callStack)-1].node
+			low[p] = min(low[p], low[u])
+
+			if low[u] > disc[p] {
+				w, _ := g.getEdge(p, u)
+				res.bridges = append(res.bridges, &WeightedEdge[T, N]{From: p, To: u, Weight: w})
+			}
+			if low[u] >= disc[p] {
+				// p separates the subtree of u from the rest of the graph
+				if len(callStack) > 1 && !isArticulation[p] {
+					isArticulation[p] = true
+					res.articulationPoints = append(res.articulationPoints, p)
+				}
+
+				seen := make(map[T]bool)
+				var component []T
+				for {
+					e := edgeStack[len(edgeStack)-1]
+					edgeStack = edgeStack[:len(edgeStack)-1]
+					for _, n := range e {
+						if !seen[n] {
+							seen[n] = true
+							component = append(component, n)
+						}
+					}
+					if e[0] == p && e[1] == u {
+						break
+					}
+				}
+				res.components = append(res.components, component)
+			}
+		}
+	}
+	return res, nil
+}
+
+// Returns the articulation points of an undirected graph, i.e. the nodes
+// whose removal increases the number of connected components.
+func (g *Graph[T, N]) ArticulationPoints() ([]T, error) {
+	res, err := g.hopcroftTarjan()
+	if err != nil {
+		return nil, err
+	}
+	return res.articulationPoints, nil
+}
+
+// Returns the bridges of an undirected graph, i.e. the edges whose removal
+// increases the number of connected components.
+func (g *Graph[T, N]) Bridges() ([]*WeightedEdge[T, N], error) {
+	res, err := g.hopcroftTarjan()
+	if err != nil {
+		return nil, err
+	}
+	return res.bridges, nil
+}
+
+// Returns the biconnected components of an undirected graph, i.e. the
+// maximal subgraphs which remain connected after removing any single node.
+// Isolated nodes are not part of any biconnected component.
+func (g *Graph[T, N]) BiconnectedComponents() ([][]T, error) {
+	res, err := g.hopcroftTarjan()
+	if err != nil {
+		return nil, err
+	}
+	return res.components, nil
+}
+
+// Returns the 2-edge-connected components of an undirected graph, i.e. the
+// connected components remaining after removing all bridges, and a map from
+// each node to the index of its component.
+func (g *Graph[T, N]) TwoEdgeConnectedComponents() ([][]T, map[T]int, error) {
+	bridges, err := g.Bridges()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := g.Clone()
+	for _, e := range bridges {
+		h.RemoveEdge(e.From, e.To)
+	}
+	return h.ConnectedComponents()
+}
@@ -0,0 +1,107 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func BiconnectedGraph() *Graph[int, int] {
+	// Two triangles 0-1-2 and 3-4-5 connected by the path 2-6-3, with an
+	// additional leaf 7 attached to 5
+	g := NewUndirectedGraph[int, int]()
+	for i := range 9 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 0, 1)
+	g.AddEdge(2, 6, 2)
+	g.AddEdge(6, 3, 3)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 3, 1)
+	g.AddEdge(5, 7, 4)
+	return g
+}
+
+func TestArticulationPoints(t *testing.T) {
+	g := BiconnectedGraph()
+	points, err := g.ArticulationPoints()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	slices.Sort(points)
+	if !slices.Equal(points, []int{2, 3, 5, 6}) {
+		t.Fatal("Invalid articulation points:", points)
+	}
+
+	// Brute-force validation on random graphs
+	for seed := range int64(5) {
+		g := RandomGraph(false, 30, 40, 1, 1, seed)
+		points, _ := g.ArticulationPoints()
+		before, _, _ := g.ConnectedComponents()
+		for n := range g.Nodes() {
+			h := g.Clone()
+			h.RemoveNode(n)
+			after, _, _ := h.ConnectedComponents()
+			if (len(after) > len(before)) != slices.Contains(points, n) {
+				t.Fatal("Invalid articulation point", n)
+			}
+		}
+	}
+}
+
+func TestBridges(t *testing.T) {
+	g := BiconnectedGraph()
+	bridges, err := g.Bridges()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	var weights []int
+	for _, e := range bridges {
+		weights = append(weights, e.Weight)
+	}
+	slices.Sort(weights)
+	if !slices.Equal(weights, []int{2, 3, 4}) {
+		t.Fatal("Invalid bridges:", bridges)
+	}
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	g := BiconnectedGraph()
+	components, err := g.BiconnectedComponents()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	for _, c := range components {
+		slices.Sort(c)
+	}
+	slices.SortFunc(components, slices.Compare)
+	t.Log(components)
+
+	expected := [][]int{{0, 1, 2}, {2, 6}, {3, 4, 5}, {3, 6}, {5, 7}}
+	if !slices.EqualFunc(components, expected, slices.Equal) {
+		t.Fatal("Invalid biconnected components:", components)
+	}
+}
+
+func TestTwoEdgeConnectedComponents(t *testing.T) {
+	g := BiconnectedGraph()
+	components, lookup, err := g.TwoEdgeConnectedComponents()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	// {0, 1, 2}, {6}, {3, 4, 5}, {7} and the isolated node {8}
+	if len(components) != 5 {
+		t.Fatal("Invalid components:", components)
+	}
+	if lookup[0] != lookup[2] || lookup[3] != lookup[5] || lookup[2] == lookup[6] {
+		t.Fatal("Invalid components:", components)
+	}
+
+	if _, _, err := WikipediaDirectedAcyclicGraph().TwoEdgeConnectedComponents(); !errors.Is(err, ErrDirected) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
@@ -0,0 +1,220 @@
+package edsger
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+)
+
+// Returns the reverse adjacency of the graph, i.e. for each node the list of
+// its predecessors with the corresponding edge weights.
+func (g *Graph[T, N]) reverseEdges() map[T][]*NodeWeight[T, N] {
+	if !g.directed {
+		return g.edges
+	}
+
+	res := make(map[T][]*NodeWeight[T, N], len(g.edges))
+	for src, edges := range g.edges {
+		for _, e := range edges {
+			res[e.Node] = append(res[e.Node], &NodeWeight[T, N]{
+				Node:   src,
+				Weight: e.Weight,
+			})
+		}
+	}
+	return res
+}
+
+// State of one direction of the bidirectional search
+type bidirectionalSearch[T comparable, N Number] struct {
+	edges   map[T][]*NodeWeight[T, N]
+	q       *priorityQueue[T, N]
+	dist    map[T]N
+	prev    map[T][]T
+	settled map[T]bool
+}
+
+func newBidirectionalSearch[T comparable, N Number](source T, edges map[T][]*NodeWeight[T, N]) *bidirectionalSearch[T, N] {
+	s := &bidirectionalSearch[T, N]{
+		edges:   edges,
+		q:       newPriorityQueue[T, N](0),
+		dist:    map[T]N{source: 0},
+		prev:    make(map[T][]T),
+		settled: make(map[T]bool),
+	}
+	s.q.Append(source, 0)
+	return s
+}
+
+// Settles the next node and relaxes its edges. Updates the best known path
+// length mu and the meeting node based on the distances of the other search.
+func (s *bidirectionalSearch[T, N]) step(other *bidirectionalSearch[T, N], mu *N, meet *T, found *bool) error {
+	u := heap.Pop(s.q).(*priorityItem[T, N])
+	s.settled[u.node] = true
+
+	for _, v := range s.edges[u.node] {
+		if v.Weight < 0 {
+			return fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, u.node, v.Node)
+		}
+		if s.settled[v.Node] {
+			continue
+		}
+
+		alt := u.prio + v.Weight
+		if dv, ok := s.dist[v.Node]; !ok || alt < dv {
+			s.dist[v.Node] = alt
+			s.prev[v.Node] = []T{u.node}
+
+			pi, ok := s.q.m[v.Node]
+			if !ok {
+				heap.Push(s.q, v.Node)
+				pi = s.q.m[v.Node]
+			}
+			s.q.update(pi, alt)
+		}
+
+		if dv, ok := other.dist[v.Node]; ok {
+			if total := s.dist[v.Node] + dv; !*found || total < *mu {
+				*mu = total
+				*meet = v.Node
+				*found = true
+			}
+		}
+	}
+	return nil
+}
+
+// Bidirectional variant of Dijkstra's shortest path algorithm. The search
+// runs simultaneously forward from source and backward from dest, and stops
+// when both searches meet. For directed graphs, the reverse adjacency is
+// computed on each call. When all edge weights are positive, ties are broken
+// as in DijkstraShortestPath such that both functions return the same path.
+func (g *Graph[T, N]) BidirectionalDijkstraShortestPath(source, dest T) ([]T, N) {
+	return must2(g.TryBidirectionalDijkstraShortestPath(source, dest))
+}
+
+// Same as BidirectionalDijkstraShortestPath but returns an error instead of panicking
+func (g *Graph[T, N]) TryBidirectionalDijkstraShortestPath(source, dest T) ([]T, N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, 0, err
+	}
+	if source == dest {
+		return []T{source}, 0, nil
+	}
+
+	forward := newBidirectionalSearch(source, g.edges)
+	backward := newBidirectionalSearch(dest, g.reverseEdges())
+
+	var mu N
+	var meet T
+	found := false
+	for forward.q.Len() > 0 && backward.q.Len() > 0 {
+		topF, topB := forward.q.items[0].prio, backward.q.items[0].prio
+		// Every node on a shortest path is settled by one of the searches
+		if found && topF+topB > mu {
+			break
+		}
+
+		var err error
+		if topF <= topB {
+			err = forward.step(backward, &mu, &meet, &found)
+		} else {
+			err = backward.step(forward, &mu, &meet, &found)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if !found {
+		// No path was found
+		return nil, 0, nil
+	}
+
+	if path := g.bidirectionalPath(source, dest, forward, backward, mu); path != nil {
+		return path, mu, nil
+	}
+
+	// Ties depend on the settling order with zero weights
+	path, _ := pathFromShortestPathMap(meet, forward.prev, mu)
+	back, _ := pathFromShortestPathMap(meet, backward.prev, mu)
+	slices.Reverse(back)
+	return append(path, back[1:]...), mu, nil
+}
+
+// Reconstructs the path returned by DijkstraShortestPath, where each node is
+// preceded by its predecessor on a shortest path with the lowest index. The
+// nodes on shortest paths are recovered from the nodes settled by both
+// searches. Returns nil if a zero weight edge is part of a tie.
+func (g *Graph[T, N]) bidirectionalPath(source, dest T, forward, backward *bidirectionalSearch[T, N], mu N) []T {
+	// Distances from source of the nodes on shortest paths
+	dist := map[T]N{dest: mu}
+	q := []T{dest}
+	add := func(v T, d N) {
+		if _, ok := dist[v]; !ok {
+			dist[v] = d
+			q = append(q, v)
+		}
+	}
+
+	// Nodes settled by the backward search where both searches meet
+	for u := range forward.settled {
+		for _, e := range forward.edges[u] {
+			if backward.settled[e.Node] && forward.dist[u]+e.Weight+backward.dist[e.Node] == mu {
+				add(e.Node, mu-backward.dist[e.Node])
+			}
+		}
+	}
+	for v := range backward.settled {
+		if forward.settled[v] && forward.dist[v]+backward.dist[v] == mu {
+			add(v, forward.dist[v])
+		}
+	}
+
+	// Follow shortest paths towards dest within the backward search
+	for i := 0; i < len(q); i++ {
+		u := q[i]
+		if !backward.settled[u] {
+			continue
+		}
+		for _, e := range forward.edges[u] {
+			if backward.settled[e.Node] && backward.dist[u] == e.Weight+backward.dist[e.Node] {
+				add(e.Node, mu-backward.dist[e.Node])
+			}
+		}
+	}
+
+	// Follow shortest paths towards source within the forward search
+	for i := 0; i < len(q); i++ {
+		v := q[i]
+		for _, e := range backward.edges[v] {
+			if forward.settled[e.Node] && forward.dist[e.Node]+e.Weight == dist[v] {
+				add(e.Node, forward.dist[e.Node])
+			}
+		}
+	}
+
+	path := []T{dest}
+	for v := dest; v != source; {
+		var u T
+		found := false
+		for _, e := range backward.edges[v] {
+			if d, ok := dist[e.Node]; !ok || e.Node == v || d+e.Weight != dist[v] {
+				continue
+			}
+			if e.Weight == 0 {
+				return nil
+			}
+			if !found || g.nodes[e.Node] < g.nodes[u] {
+				u, found = e.Node, true
+			}
+		}
+		if !found {
+			return nil
+		}
+		path = append(path, u)
+		v = u
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,79 @@
+package edsger
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBidirectionalDijkstraWikipediaGraph(t *testing.T) {
+	g := WikipediaGraph()
+	if path, total := g.BidirectionalDijkstraShortestPath(1, 5); total != 20 {
+		t.Fatal("Invalid path:", path)
+	}
+}
+
+func TestBidirectionalDijkstraNoPath(t *testing.T) {
+	g := NoPathGraph()
+	path, total := g.BidirectionalDijkstraShortestPath(1, 5)
+	if len(path) != 0 || total != 0 {
+		t.Fatal("Invalid path")
+	}
+}
+
+func TestBidirectionalDijkstraRandomGraphs(t *testing.T) {
+	for seed := range int64(5) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 30, 90, 0, 20, seed)
+			for src := range g.Nodes() {
+				for dst := range g.Nodes() {
+					expectedPath, expected := g.DijkstraShortestPath(src, dst)
+					path, total := g.BidirectionalDijkstraShortestPath(src, dst)
+					if total != expected || (path == nil) != (expectedPath == nil) {
+						t.Fatalf("Invalid path from %d to %d: %v %d (expected %v %d)", src, dst, path, total, expectedPath, expected)
+					}
+					if path != nil {
+						if path[0] != src || path[len(path)-1] != dst {
+							t.Fatal("Invalid path:", path)
+						}
+						validatePath(t, g, path, total)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBidirectionalDijkstraTiedPaths(t *testing.T) {
+	// Small positive weights give many shortest paths of equal length, such
+	// that both searches must break ties in the same way
+	for seed := range int64(5) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 30, 120, 1, 3, seed)
+			for src := range g.Nodes() {
+				for dst := range g.Nodes() {
+					expectedPath, expected := g.DijkstraShortestPath(src, dst)
+					path, total := g.BidirectionalDijkstraShortestPath(src, dst)
+					if total != expected || !slices.Equal(path, expectedPath) {
+						t.Fatalf("Invalid path from %d to %d: %v %d (expected %v %d)", src, dst, path, total, expectedPath, expected)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkBidirectionalDijkstraShortestPath(b *testing.B) {
+	g := KarateClubGraph()
+	nodes := slices.Collect(g.Nodes())
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, src := range nodes {
+			for _, dst := range nodes {
+				if src != dst {
+					g.BidirectionalDijkstraShortestPath(src, dst)
+				}
+			}
+		}
+	}
+}
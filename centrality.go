@@ -1,12 +1,18 @@
 package edsger
 
 import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 )
 
 // Computes betweenness centrality
 // Betweenness centrality of a node $v$ is the sum of all-pairs shortest paths that pass through $v$
+// Only one shortest path is counted for each pair of nodes, see BrandesBetweennessCentrality for
+// the fraction of shortest paths passing through each node.
 func (g *Graph[T, N]) BetweennessCentrality() map[T]int {
 	return g.BetweennessCentralitySubset(g.NodesList())
 }
@@ -26,7 +32,7 @@ func (g *Graph[T, N]) BetweennessCentralitySubset(subset []T) map[T]int {
 		go func() {
 			defer wg.Done()
 
-			smap := g.sourceShortestPathMap(source, false, nil)
+			smap, _ := must2(g.sourceShortestPathMap(source, false, nil))
 			for _, dest := range subset {
 				if source == dest || g.HasEdge(source, dest) {
 					continue
@@ -50,6 +56,8 @@ func (g *Graph[T, N]) BetweennessCentralitySubset(subset []T) map[T]int {
 
 // Computes betweenness centrality using all shortest paths
 // Betweenness centrality of a node $v$ is the sum of all-pairs all shortest paths that pass through $v$
+// Each pair of nodes counts once for each node on any of its shortest paths, see
+// BrandesBetweennessCentrality for the fraction of shortest paths passing through each node.
 func (g *Graph[T, N]) AllShortestPathsBetweennessCentrality() map[T]int {
 	return g.AllShortestPathsBetweennessCentralitySubset(g.NodesList())
 }
@@ -98,3 +106,332 @@ func atomicUint64toIntMap[T comparable](m map[T]*atomic.Uint64) map[T]int {
 	}
 	return res
 }
+
+// Options of the Brandes betweenness centrality computation
+type BetweennessOptions struct {
+	// Normalizes the scores by the number of pairs of nodes
+	Normalized bool
+	// Includes the endpoints of the shortest paths in the scores
+	Endpoints bool
+	// Maximum number of parallel workers. Uses GOMAXPROCS if not set.
+	Workers int
+}
+
+// Edge of the graph using node indices
+type indexedEdge[N Number] struct {
+	to     int
+	weight N
+}
+
+// Returns the adjacency of the graph using the node indices of NodesList
+func (g *Graph[T, N]) indexedAdjacency() [][]indexedEdge[N] {
+	adj := make([][]indexedEdge[N], len(g.nodes))
+	for src, edges := range g.edges {
+		i := g.nodes[src]
+		adj[i] = make([]indexedEdge[N], len(edges))
+		for j, e := range edges {
+			adj[i][j] = indexedEdge[N]{to: g.nodes[e.Node], weight: e.Weight}
+		}
+	}
+	return adj
+}
+
+// Shortest paths from a single source as computed by Brandes' algorithm
+type shortestPathCounts struct {
+	// Reachable nodes in order of non-decreasing distance from the source
+	order []int
+	// Predecessors of each node on the shortest paths from the source
+	preds [][]int
+	// Number of shortest paths from the source to each node
+	sigma []float64
+}
+
+// Dijkstra's algorithm counting the number of shortest paths to each node
+func singleSourceShortestPathCounts[N Number](adj [][]indexedEdge[N], s int) *shortestPathCounts {
+	n := len(adj)
+	res := &shortestPathCounts{
+		preds: make([][]int, n),
+		sigma: make([]float64, n),
+	}
+	dist := make([]N, n)
+	seen := make([]bool, n)
+	settled := make([]bool, n)
+
+	res.sigma[s] = 1
+	seen[s] = true
+	q := newPriorityQueue[int, N](0)
+	q.Append(s, 0)
+	for q.Len() > 0 {
+		v := heap.Pop(q).(*priorityItem[int, N]).node
+		settled[v] = true
+		res.order = append(res.order, v)
+
+		for _, e := range adj[v] {
+			w := e.to
+			if settled[w] {
+				continue
+			}
+
+			alt := dist[v] + e.weight
+			if !seen[w] || alt < dist[w] {
+				seen[w] = true
+				dist[w] = alt
+				res.sigma[w] = res.sigma[v]
+				res.preds[w] = append(res.preds[w][:0], v)
+
+				pi, ok := q.m[w]
+				if !ok {
+					heap.Push(q, w)
+					pi = q.m[w]
+				}
+				q.update(pi, alt)
+			} else if alt == dist[w] {
+				res.sigma[w] += res.sigma[v]
+				res.preds[w] = append(res.preds[w], v)
+			}
+		}
+	}
+	return res
+}
+
+// Returns an error if any edge of the graph has a negative weight
+func (g *Graph[T, N]) checkNonNegativeWeights() error {
+	for src, edges := range g.edges {
+		for _, e := range edges {
+			if e.Weight < 0 {
+				return fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, src, e.Node)
+			}
+		}
+	}
+	return nil
+}
+
+// Runs the single source stage of Brandes' algorithm from each of the sources
+// using a bounded number of workers, and calls fn with the result
+func (g *Graph[T, N]) brandes(sources []T, workers int, fn func(worker, s int, spc *shortestPathCounts)) error {
+	if err := g.checkNonNegativeWeights(); err != nil {
+		return err
+	}
+	adj := g.indexedAdjacency()
+	parallelEach(sources, workers, func(worker int, source T) {
+		s := g.nodes[source]
+		fn(worker, s, singleSourceShortestPathCounts(adj, s))
+	})
+	return nil
+}
+
+// Computes betweenness centrality using Brandes' algorithm. The betweenness
+// centrality of a node $v$ is the sum over all pairs of nodes $s, t$ of the
+// fraction of shortest paths from $s$ to $t$ passing through $v$. Edge
+// weights are used as distances and must be non-negative.
+func (g *Graph[T, N]) BrandesBetweennessCentrality(opts BetweennessOptions) (map[T]float64, error) {
+	return g.BrandesBetweennessCentralitySubset(g.NodesList(), opts)
+}
+
+// Same as BrandesBetweennessCentrality but only uses the shortest paths
+// starting from the given sources. When the sources are a subset of the
+// nodes, the scores are an estimate rescaled by the fraction of sources.
+func (g *Graph[T, N]) BrandesBetweennessCentralitySubset(sources []T, opts BetweennessOptions) (map[T]float64, error) {
+	for _, s := range sources {
+		if err := g.checkNode(s); err != nil {
+			return nil, err
+		}
+	}
+
+	n := len(g.nodes)
+	workers := numWorkers(opts.Workers, len(sources))
+	scores := make([][]float64, workers)
+	for w := range scores {
+		scores[w] = make([]float64, n)
+	}
+
+	err := g.brandes(sources, workers, func(worker, s int, spc *shortestPathCounts) {
+		bc := scores[worker]
+		delta := make([]float64, n)
+		if opts.Endpoints {
+			bc[s] += float64(len(spc.order) - 1)
+		}
+		for _, w := range slices.Backward(spc.order) {
+			coeff := (1 + delta[w]) / spc.sigma[w]
+			for _, v := range spc.preds[w] {
+				delta[v] += spc.sigma[v] * coeff
+			}
+			if w != s {
+				bc[w] += delta[w]
+				if opts.Endpoints {
+					bc[w]++
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scale := betweennessScale(n, len(sources), g.directed, opts)
+	res := make(map[T]float64, n)
+	for i, node := range g.NodesList() {
+		var sum float64
+		for _, bc := range scores {
+			sum += bc[i]
+		}
+		res[node] = sum * scale
+	}
+	return res, nil
+}
+
+// Returns the factor by which betweenness scores are rescaled
+func betweennessScale(n, sources int, directed bool, opts BetweennessOptions) float64 {
+	scale := 1.0
+	if opts.Normalized {
+		pairs := n - 2
+		if opts.Endpoints {
+			pairs = n
+		}
+		if n > 1 && pairs > 0 {
+			scale = 1 / (float64(n-1) * float64(pairs))
+		}
+	} else if !directed {
+		// Each shortest path is counted in both directions
+		scale = 0.5
+	}
+	if sources > 0 && sources < n {
+		scale *= float64(n) / float64(sources)
+	}
+	return scale
+}
+
+// Computes edge betweenness centrality using Brandes' algorithm. The
+// betweenness centrality of an edge is the sum over all pairs of nodes of the
+// fraction of shortest paths passing through the edge. Edges are keyed as
+// returned by Edges(). Edge weights are used as distances and must be
+// non-negative. The Endpoints option is ignored.
+func (g *Graph[T, N]) EdgeBetweennessCentrality(opts BetweennessOptions) (map[WeightedEdge[T, N]]float64, error) {
+	var edges []WeightedEdge[T, N]
+	ids := make(map[edgeKey[int]]int)
+	for e := range g.Edges() {
+		from, to := g.nodes[e.From], g.nodes[e.To]
+		ids[edgeKey[int]{from, to}] = len(edges)
+		if !g.directed {
+			ids[edgeKey[int]{to, from}] = len(edges)
+		}
+		edges = append(edges, *e)
+	}
+
+	n := len(g.nodes)
+	sources := g.NodesList()
+	workers := numWorkers(opts.Workers, n)
+	scores := make([][]float64, workers)
+	for w := range scores {
+		scores[w] = make([]float64, len(edges))
+	}
+
+	err := g.brandes(sources, workers, func(worker, s int, spc *shortestPathCounts) {
+		bc := scores[worker]
+		delta := make([]float64, n)
+		for _, w := range slices.Backward(spc.order) {
+			coeff := (1 + delta[w]) / spc.sigma[w]
+			for _, v := range spc.preds[w] {
+				c := spc.sigma[v] * coeff
+				bc[ids[edgeKey[int]{v, w}]] += c
+				delta[v] += c
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scale := 1.0
+	if opts.Normalized {
+		if n > 1 {
+			scale = 1 / (float64(n) * float64(n-1))
+		}
+	} else if !g.directed {
+		// Each shortest path is counted in both directions
+		scale = 0.5
+	}
+	res := make(map[WeightedEdge[T, N]]float64, len(edges))
+	for i, e := range edges {
+		var sum float64
+		for _, bc := range scores {
+			sum += bc[i]
+		}
+		res[e] = sum * scale
+	}
+	return res, nil
+}
+
+// Calls fn with the shortest path distances from each of the nodes to the
+// nodes reachable from it, using one worker per CPU. Calls to fn are not
+// synchronized.
+func (g *Graph[T, N]) eachSourceDistances(fn func(source T, dist map[T]N)) error {
+	nodes := g.NodesList()
+	workers := numWorkers(0, len(nodes))
+	errs := make([]error, workers)
+	parallelEach(nodes, workers, func(worker int, source T) {
+		if errs[worker] != nil {
+			return
+		}
+		_, dist, err := g.sourceShortestPathMap(source, false, nil)
+		if err != nil {
+			errs[worker] = err
+			return
+		}
+		fn(source, dist)
+	})
+	return errors.Join(errs...)
+}
+
+// Computes closeness centrality. The closeness centrality of a node $u$ is
+// the inverse of the average shortest path distance from $u$ to the nodes
+// reachable from $u$. For disconnected graphs, it is scaled by the fraction of
+// reachable nodes following Wasserman and Faust. Edge weights are used as
+// distances and must be non-negative.
+func (g *Graph[T, N]) ClosenessCentrality() (map[T]float64, error) {
+	n := len(g.nodes)
+	res := make(map[T]float64, n)
+	var mu sync.Mutex
+	err := g.eachSourceDistances(func(source T, dist map[T]N) {
+		var sum float64
+		for _, d := range dist {
+			sum += float64(d)
+		}
+
+		var c float64
+		if r := float64(len(dist) - 1); sum > 0 {
+			c = r / sum * r / float64(n-1)
+		}
+		mu.Lock()
+		res[source] = c
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Computes harmonic centrality. The harmonic centrality of a node $u$ is the
+// sum of the inverse of the shortest path distances from $u$ to all other
+// nodes, where unreachable nodes contribute zero. Edge weights are used as
+// distances and must be non-negative.
+func (g *Graph[T, N]) HarmonicCentrality() (map[T]float64, error) {
+	res := make(map[T]float64, len(g.nodes))
+	var mu sync.Mutex
+	err := g.eachSourceDistances(func(source T, dist map[T]N) {
+		var c float64
+		for _, d := range dist {
+			if d > 0 {
+				c += 1 / float64(d)
+			}
+		}
+		mu.Lock()
+		res[source] = c
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
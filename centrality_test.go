@@ -0,0 +1,300 @@
+package edsger
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Path graph 0 - 1 - ... - n-1
+func PathGraph(n int) *Graph[int, int] {
+	g := NewUndirectedGraph[int, int]()
+	for i := range n {
+		g.AddNode(i)
+	}
+	for i := 1; i < n; i++ {
+		g.AddEdge(i-1, i, 1)
+	}
+	return g
+}
+
+// Karate club graph without weights
+func UnweightedKarateClubGraph() *Graph[int, int] {
+	g := NewUndirectedGraph[int, int]()
+	kc := KarateClubGraph()
+	for node := range kc.Nodes() {
+		g.AddNode(node)
+	}
+	for e := range kc.Edges() {
+		g.AddEdge(e.From, e.To, 1)
+	}
+	return g
+}
+
+func assertScores[T comparable](t *testing.T, got, expected map[T]float64) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d scores, got %d", len(expected), len(got))
+	}
+	for node, score := range expected {
+		if math.Abs(got[node]-score) > 1e-9 {
+			t.Fatalf("Invalid score for %v: expected %v, got %v", node, score, got[node])
+		}
+	}
+}
+
+// Betweenness computed by enumerating all the simple paths between each pair
+func bruteForceBetweenness(g *Graph[int, int]) map[int]float64 {
+	res := make(map[int]float64)
+	for s := range g.Nodes() {
+		res[s] += 0
+		for d := range g.Nodes() {
+			if s == d {
+				continue
+			}
+
+			var paths [][]int
+			best := -1
+			it := g.AllSimplePaths(s, d)
+			for it.Next() {
+				path, cost := it.Get()
+				if best == -1 || cost < best {
+					best = cost
+					paths = nil
+				}
+				if cost == best {
+					paths = append(paths, append([]int(nil), path...))
+				}
+			}
+			for _, path := range paths {
+				for _, v := range path[1 : len(path)-1] {
+					res[v] += 1 / float64(len(paths))
+				}
+			}
+		}
+	}
+	if !g.directed {
+		for v := range res {
+			res[v] /= 2
+		}
+	}
+	return res
+}
+
+// Edge betweenness computed by enumerating all the simple paths between each pair
+func bruteForceEdgeBetweenness(g *Graph[int, int]) map[WeightedEdge[int, int]]float64 {
+	res := make(map[WeightedEdge[int, int]]float64)
+	keys := make(map[edgeKey[int]]WeightedEdge[int, int])
+	for e := range g.Edges() {
+		res[*e] = 0
+		keys[edgeKey[int]{e.From, e.To}] = *e
+		if !g.directed {
+			keys[edgeKey[int]{e.To, e.From}] = *e
+		}
+	}
+	for s := range g.Nodes() {
+		for d := range g.Nodes() {
+			if s == d {
+				continue
+			}
+
+			var paths [][]int
+			best := -1
+			it := g.AllSimplePaths(s, d)
+			for it.Next() {
+				path, cost := it.Get()
+				if best == -1 || cost < best {
+					best = cost
+					paths = nil
+				}
+				if cost == best {
+					paths = append(paths, append([]int(nil), path...))
+				}
+			}
+			for _, path := range paths {
+				for i := 1; i < len(path); i++ {
+					res[keys[edgeKey[int]{path[i-1], path[i]}]] += 1 / float64(len(paths))
+				}
+			}
+		}
+	}
+	if !g.directed {
+		for e := range res {
+			res[e] /= 2
+		}
+	}
+	return res
+}
+
+func TestBrandesBetweennessCentralityPath(t *testing.T) {
+	g := PathGraph(5)
+
+	bc, err := g.BrandesBetweennessCentrality(BetweennessOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, bc, map[int]float64{0: 0, 1: 3, 2: 4, 3: 3, 4: 0})
+
+	bc, _ = g.BrandesBetweennessCentrality(BetweennessOptions{Normalized: true})
+	assertScores(t, bc, map[int]float64{0: 0, 1: 0.5, 2: 4.0 / 6, 3: 0.5, 4: 0})
+
+	bc, _ = g.BrandesBetweennessCentrality(BetweennessOptions{Endpoints: true})
+	assertScores(t, bc, map[int]float64{0: 4, 1: 7, 2: 8, 3: 7, 4: 4})
+}
+
+func TestBrandesBetweennessCentralityFractions(t *testing.T) {
+	// Two shortest paths of equal weight from 0 to 3
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 3, 2)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(2, 3, 1)
+
+	bc, err := g.BrandesBetweennessCentrality(BetweennessOptions{Workers: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, bc, map[int]float64{0: 0, 1: 0.5, 2: 0.5, 3: 0})
+}
+
+func TestBrandesBetweennessCentralityKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	bc, err := g.BrandesBetweennessCentrality(BetweennessOptions{Normalized: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Reference values from networkx.betweenness_centrality
+	for node, score := range map[int]float64{0: 0.43763528138528146, 33: 0.30407497594997596, 32: 0.145247113997114, 9: 0.0008477633477633478, 11: 0} {
+		if math.Abs(bc[node]-score) > 1e-9 {
+			t.Fatalf("Invalid score for %d: expected %v, got %v", node, score, bc[node])
+		}
+	}
+}
+
+func TestBrandesBetweennessCentralityBruteForce(t *testing.T) {
+	for seed := range int64(10) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 8, 20, 1, 3, seed)
+
+			bc, err := g.BrandesBetweennessCentrality(BetweennessOptions{Workers: 3})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertScores(t, bc, bruteForceBetweenness(g))
+		}
+	}
+}
+
+func TestBrandesBetweennessCentralitySubset(t *testing.T) {
+	g := PathGraph(5)
+
+	bc, err := g.BrandesBetweennessCentralitySubset([]int{0}, BetweennessOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Paths from node 0 only, rescaled by the 5 nodes
+	assertScores(t, bc, map[int]float64{0: 0, 1: 7.5, 2: 5, 3: 2.5, 4: 0})
+
+	if _, err := g.BrandesBetweennessCentralitySubset([]int{42}, BetweennessOptions{}); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatalf("Expected ErrNodeNotFound, got %v", err)
+	}
+}
+
+func TestBrandesBetweennessCentralityNegativeWeight(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, -1)
+
+	if _, err := g.BrandesBetweennessCentrality(BetweennessOptions{}); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestEdgeBetweennessCentralityPath(t *testing.T) {
+	g := PathGraph(4)
+
+	ebc, err := g.EdgeBetweennessCentrality(BetweennessOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, ebc, map[WeightedEdge[int, int]]float64{
+		{From: 1, To: 0, Weight: 1}: 3,
+		{From: 2, To: 1, Weight: 1}: 4,
+		{From: 3, To: 2, Weight: 1}: 3,
+	})
+
+	ebc, _ = g.EdgeBetweennessCentrality(BetweennessOptions{Normalized: true})
+	assertScores(t, ebc, map[WeightedEdge[int, int]]float64{
+		{From: 1, To: 0, Weight: 1}: 0.5,
+		{From: 2, To: 1, Weight: 1}: 4.0 / 6,
+		{From: 3, To: 2, Weight: 1}: 0.5,
+	})
+}
+
+func TestEdgeBetweennessCentralityBruteForce(t *testing.T) {
+	for seed := range int64(10) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 8, 20, 1, 3, seed)
+
+			ebc, err := g.EdgeBetweennessCentrality(BetweennessOptions{Workers: 3})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertScores(t, ebc, bruteForceEdgeBetweenness(g))
+		}
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	g := PathGraph(5)
+
+	cc, err := g.ClosenessCentrality()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, cc, map[int]float64{0: 0.4, 1: 4.0 / 7, 2: 4.0 / 6, 3: 4.0 / 7, 4: 0.4})
+
+	// Reference values from networkx.closeness_centrality
+	cc, _ = UnweightedKarateClubGraph().ClosenessCentrality()
+	for node, score := range map[int]float64{0: 0.5689655172413793, 33: 0.55} {
+		if math.Abs(cc[node]-score) > 1e-9 {
+			t.Fatalf("Invalid score for %d: expected %v, got %v", node, score, cc[node])
+		}
+	}
+}
+
+func TestClosenessCentralityDisconnected(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 2)
+	g.AddEdge(1, 2, 2)
+
+	cc, err := g.ClosenessCentrality()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, cc, map[int]float64{0: 2.0 / 6 * 2.0 / 3, 1: 1.0 / 2 * 1.0 / 3, 2: 0, 3: 0})
+}
+
+func TestHarmonicCentrality(t *testing.T) {
+	g := PathGraph(5)
+
+	hc, err := g.HarmonicCentrality()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, hc, map[int]float64{0: 25.0 / 12, 1: 2.5 + 1.0/3, 2: 3, 3: 2.5 + 1.0/3, 4: 25.0 / 12})
+
+	g.AddNode(5)
+	hc, _ = g.HarmonicCentrality()
+	if hc[5] != 0 || hc[2] != 3 {
+		t.Fatalf("Invalid scores with an isolated node: %v", hc)
+	}
+}
@@ -0,0 +1,441 @@
+package edsger
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+)
+
+// Options of the community detection algorithms
+type CommunityOptions struct {
+	// Resolution of the modularity. Values larger than one favor smaller
+	// communities. Uses 1 if not set.
+	Resolution float64
+	// Seed of the random number generator used to order the nodes
+	Seed int64
+}
+
+func (o CommunityOptions) resolution() float64 {
+	if o.Resolution == 0 {
+		return 1
+	}
+	return o.Resolution
+}
+
+// Returns the modularity of the partition of the nodes into communities,
+// given as a map from each node to its community
+func (g *Graph[T, N]) Modularity(partition map[T]int) (float64, error) {
+	return g.ModularityWithResolution(partition, 1)
+}
+
+// Returns the modularity of the partition with the given resolution. For
+// directed graphs, the directed variant of the modularity is used, where the
+// expected weight of an edge is the product of the out-degree of its source
+// and the in-degree of its destination.
+func (g *Graph[T, N]) ModularityWithResolution(partition map[T]int, resolution float64) (float64, error) {
+	for node := range partition {
+		if err := g.checkNode(node); err != nil {
+			return 0, err
+		}
+	}
+	if err := g.checkNonNegativeWeights(); err != nil {
+		return 0, err
+	}
+
+	// Total weight, weight inside communities and degrees of the communities.
+	// Edges of undirected graphs are counted in both directions.
+	var total, inside float64
+	out := make(map[int]float64)
+	in := make(map[int]float64)
+	for src, edges := range g.edges {
+		c, ok := partition[src]
+		if !ok {
+			return 0, fmt.Errorf("%w: no community for %v", ErrInvalidPartition, src)
+		}
+		for _, e := range edges {
+			d, ok := partition[e.Node]
+			if !ok {
+				return 0, fmt.Errorf("%w: no community for %v", ErrInvalidPartition, e.Node)
+			}
+			w := float64(e.Weight)
+			total += w
+			out[c] += w
+			in[d] += w
+			if c == d {
+				inside += w
+			}
+		}
+	}
+	if total == 0 {
+		return 0, nil
+	}
+
+	q := inside / total
+	for c, k := range out {
+		q -= resolution * k * in[c] / (total * total)
+	}
+	return q, nil
+}
+
+// Undirected weighted graph on node indices used by the community detection
+// algorithms, where parallel edges are merged and self-loops stored apart
+type communityGraph struct {
+	// Sorted neighbors of each node without self-loops
+	adj [][]indexedEdge[float64]
+	// Weight of the self-loop of each node
+	loops []float64
+	// Weighted degree of each node, where self-loops count twice
+	degree []float64
+	// Total weight of the edges
+	m float64
+}
+
+// Returns the community graph of g. Directions of the edges of directed
+// graphs are ignored.
+func (g *Graph[T, N]) communityGraph() (*communityGraph, error) {
+	if err := g.checkNonNegativeWeights(); err != nil {
+		return nil, err
+	}
+
+	n := len(g.nodes)
+	weights := make([]map[int]float64, n)
+	loops := make([]float64, n)
+	for i := range weights {
+		weights[i] = make(map[int]float64)
+	}
+	for src, edges := range g.edges {
+		u := g.nodes[src]
+		for _, e := range edges {
+			v, w := g.nodes[e.Node], float64(e.Weight)
+			if !g.directed {
+				// Edges are stored in both directions
+				w /= 2
+			}
+			if u == v {
+				loops[u] += w
+			} else {
+				weights[u][v] += w
+				weights[v][u] += w
+			}
+		}
+	}
+	return newCommunityGraph(weights, loops), nil
+}
+
+func newCommunityGraph(weights []map[int]float64, loops []float64) *communityGraph {
+	n := len(weights)
+	cg := &communityGraph{
+		adj:    make([][]indexedEdge[float64], n),
+		loops:  loops,
+		degree: make([]float64, n),
+	}
+	for u, neighbors := range weights {
+		cg.degree[u] = 2 * loops[u]
+		for v, w := range neighbors {
+			cg.adj[u] = append(cg.adj[u], indexedEdge[float64]{to: v, weight: w})
+			cg.degree[u] += w
+		}
+		slices.SortFunc(cg.adj[u], func(a, b indexedEdge[float64]) int {
+			return cmp.Compare(a.to, b.to)
+		})
+		cg.m += cg.degree[u]
+	}
+	cg.m /= 2
+	return cg
+}
+
+// Returns the graph where each community is merged into a single node
+func (cg *communityGraph) aggregate(comm []int, k int) *communityGraph {
+	weights := make([]map[int]float64, k)
+	loops := make([]float64, k)
+	for c := range weights {
+		weights[c] = make(map[int]float64)
+	}
+	for u, edges := range cg.adj {
+		c := comm[u]
+		loops[c] += cg.loops[u]
+		for _, e := range edges {
+			if d := comm[e.to]; c == d {
+				// Internal edges are seen from both of their nodes
+				loops[c] += e.weight / 2
+			} else {
+				weights[c][d] += e.weight
+			}
+		}
+	}
+	return newCommunityGraph(weights, loops)
+}
+
+// Moves nodes to the neighboring community maximizing the modularity gain
+// until no move improves the modularity. Returns whether any node was moved.
+func (cg *communityGraph) localMove(comm []int, resolution float64, rng *rand.Rand) bool {
+	n := len(cg.adj)
+	if cg.m == 0 {
+		return false
+	}
+
+	tot := make([]float64, n)
+	for u, c := range comm {
+		tot[c] += cg.degree[u]
+	}
+	weights := make([]float64, n)
+	seen := make([]bool, n)
+	var neighbors []int
+
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for _, u := range rng.Perm(n) {
+			neighbors = neighbors[:0]
+			for _, e := range cg.adj[u] {
+				c := comm[e.to]
+				if !seen[c] {
+					seen[c] = true
+					neighbors = append(neighbors, c)
+				}
+				weights[c] += e.weight
+			}
+			slices.Sort(neighbors)
+
+			cu, ku := comm[u], cg.degree[u]
+			tot[cu] -= ku
+			best := cu
+			bestGain := weights[cu] - resolution*tot[cu]*ku/(2*cg.m)
+			for _, c := range neighbors {
+				if gain := weights[c] - resolution*tot[c]*ku/(2*cg.m); gain > bestGain+1e-12 {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += ku
+			comm[u] = best
+			if best != cu {
+				improved, moved = true, true
+			}
+
+			for _, c := range neighbors {
+				seen[c] = false
+				weights[c] = 0
+			}
+		}
+	}
+	return moved
+}
+
+// Refines the communities by merging nodes into well-connected subsets of
+// their community, as in the Leiden algorithm. Returns the refined
+// communities and their number.
+func (cg *communityGraph) refine(comm []int, k int, resolution float64, rng *rand.Rand) ([]int, int) {
+	const theta = 0.01
+	n := len(cg.adj)
+	refined := make([]int, n)
+	// Degree of the communities and the refined communities, and weight
+	// between each refined community and the rest of its community
+	tot := make([]float64, k)
+	rtot := make([]float64, n)
+	rext := make([]float64, n)
+	size := make([]int, n)
+	for u := range refined {
+		refined[u] = u
+		tot[comm[u]] += cg.degree[u]
+		rtot[u] = cg.degree[u]
+		size[u] = 1
+		for _, e := range cg.adj[u] {
+			if comm[e.to] == comm[u] {
+				rext[u] += e.weight
+			}
+		}
+	}
+
+	weights := make([]float64, n)
+	seen := make([]bool, n)
+	var candidates []int
+	var gains, probs []float64
+	for _, u := range rng.Perm(n) {
+		c, ku := comm[u], cg.degree[u]
+		r := refined[u]
+		if size[r] > 1 || rext[u] < resolution*ku*(tot[c]-ku)/(2*cg.m) {
+			// Only well-connected singletons are merged
+			continue
+		}
+
+		candidates = append(candidates[:0], r)
+		for _, e := range cg.adj[u] {
+			if comm[e.to] != c {
+				continue
+			}
+			d := refined[e.to]
+			if !seen[d] && d != r {
+				seen[d] = true
+				candidates = append(candidates, d)
+			}
+			weights[d] += e.weight
+		}
+		slices.Sort(candidates[1:])
+
+		// Choose a well-connected refined community at random, favoring
+		// larger modularity gains. The gain of staying alone is zero.
+		gains = append(gains[:0], 0)
+		var maxGain float64
+		for _, d := range candidates[1:] {
+			gain := math.Inf(-1)
+			if rext[d] >= resolution*rtot[d]*(tot[c]-rtot[d])/(2*cg.m) {
+				if g := (weights[d] - resolution*rtot[d]*ku/(2*cg.m)) / cg.m; g >= 0 {
+					gain = g
+					maxGain = max(maxGain, g)
+				}
+			}
+			gains = append(gains, gain)
+		}
+		probs = probs[:0]
+		var sum float64
+		for _, gain := range gains {
+			p := math.Exp((gain - maxGain) / theta)
+			probs = append(probs, p)
+			sum += p
+		}
+		x := rng.Float64() * sum
+		best := r
+		for i, p := range probs {
+			if x < p {
+				best = candidates[i]
+				break
+			}
+			x -= p
+		}
+
+		if best != r {
+			refined[u] = best
+			rext[best] += rext[u] - 2*weights[best]
+			rtot[best] += ku
+			size[best]++
+			size[r] = 0
+		}
+		for _, d := range candidates {
+			seen[d] = false
+			weights[d] = 0
+		}
+	}
+	return refined, relabel(refined)
+}
+
+// Relabels the communities with consecutive integers in order of appearance
+// and returns their number
+func relabel(comm []int) int {
+	labels := make(map[int]int)
+	for i, c := range comm {
+		l, ok := labels[c]
+		if !ok {
+			l = len(labels)
+			labels[c] = l
+		}
+		comm[i] = l
+	}
+	return len(labels)
+}
+
+// Detects communities using the Louvain algorithm, which greedily moves
+// nodes between communities to maximize the modularity and then merges each
+// community into a single node, until the modularity cannot be improved.
+// Edge weights are used and must be non-negative, and edge directions are
+// ignored. Returns a map from each node to its community and the modularity
+// of the partition as computed by ModularityWithResolution.
+func (g *Graph[T, N]) CommunitiesLouvain(opts CommunityOptions) (map[T]int, float64, error) {
+	cg, err := g.communityGraph()
+	if err != nil {
+		return nil, 0, err
+	}
+	resolution := opts.resolution()
+	rng := rand.New(rand.NewSource(opts.Seed))
+
+	membership := identity(len(cg.adj))
+	for {
+		comm := identity(len(cg.adj))
+		if !cg.localMove(comm, resolution, rng) {
+			break
+		}
+		k := relabel(comm)
+		for i, c := range membership {
+			membership[i] = comm[c]
+		}
+		cg = cg.aggregate(comm, k)
+	}
+	partition := g.partition(membership)
+	q, err := g.ModularityWithResolution(partition, resolution)
+	if err != nil {
+		return nil, 0, err
+	}
+	return partition, q, nil
+}
+
+// Detects communities using the Leiden algorithm. It extends the Louvain
+// algorithm with a refinement phase, such that nodes are only merged with
+// nodes of their community they are well connected to. This guarantees that
+// communities are connected. Edge weights are used and must be non-negative,
+// and edge directions are ignored. Returns a map from each node to its
+// community and the modularity of the partition as computed by
+// ModularityWithResolution.
+func (g *Graph[T, N]) CommunitiesLeiden(opts CommunityOptions) (map[T]int, float64, error) {
+	cg, err := g.communityGraph()
+	if err != nil {
+		return nil, 0, err
+	}
+	resolution := opts.resolution()
+	rng := rand.New(rand.NewSource(opts.Seed))
+
+	membership := identity(len(cg.adj))
+	comm := identity(len(cg.adj))
+	for {
+		cg.localMove(comm, resolution, rng)
+		k := relabel(comm)
+		if k == len(cg.adj) {
+			break
+		}
+
+		refined, kr := cg.refine(comm, k, resolution, rng)
+		if kr == len(cg.adj) {
+			break
+		}
+
+		// The aggregated nodes start in the community of their nodes
+		next := make([]int, kr)
+		for u, r := range refined {
+			next[r] = comm[u]
+		}
+		for i, r := range membership {
+			membership[i] = refined[r]
+		}
+		cg = cg.aggregate(refined, kr)
+		comm = next
+	}
+
+	for i, c := range membership {
+		membership[i] = comm[c]
+	}
+	relabel(membership)
+	partition := g.partition(membership)
+	q, err := g.ModularityWithResolution(partition, resolution)
+	if err != nil {
+		return nil, 0, err
+	}
+	return partition, q, nil
+}
+
+// Returns the partition of the nodes from the communities of the node
+// indices
+func (g *Graph[T, N]) partition(membership []int) map[T]int {
+	res := make(map[T]int, len(membership))
+	for i, node := range g.NodesList() {
+		res[node] = membership[i]
+	}
+	return res
+}
+
+func identity(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i
+	}
+	return res
+}
@@ -0,0 +1,266 @@
+package edsger
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"testing"
+)
+
+// Two cliques of n nodes connected by a single edge
+func BarbellGraph(n int) *Graph[int, int] {
+	g := NewUndirectedGraph[int, int]()
+	for i := range 2 * n {
+		g.AddNode(i)
+	}
+	for k := range 2 {
+		for i := range n {
+			for j := range i {
+				g.AddEdge(k*n+i, k*n+j, 1)
+			}
+		}
+	}
+	g.AddEdge(n-1, n, 1)
+	return g
+}
+
+// Returns the communities of the partition
+func communities[T comparable](partition map[T]int) [][]T {
+	var res [][]T
+	for node, c := range partition {
+		for len(res) <= c {
+			res = append(res, nil)
+		}
+		res[c] = append(res[c], node)
+	}
+	return res
+}
+
+// Checks that each community induces a connected subgraph
+func validateConnectedCommunities[T comparable, N Number](t *testing.T, g *Graph[T, N], partition map[T]int) {
+	t.Helper()
+	for c, community := range communities(partition) {
+		if len(community) == 0 {
+			t.Fatalf("Community %d is empty", c)
+		}
+		seen := map[T]bool{community[0]: true}
+		queue := []T{community[0]}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, e := range g.Neighbors(u) {
+				if partition[e.Node] == c && !seen[e.Node] {
+					seen[e.Node] = true
+					queue = append(queue, e.Node)
+				}
+			}
+		}
+		if len(seen) != len(community) {
+			t.Fatalf("Community %d is not connected", c)
+		}
+	}
+}
+
+func TestModularity(t *testing.T) {
+	// Two triangles connected by a bridge
+	g := NewUndirectedGraph[int, int]()
+	for i := range 6 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 0, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 3, 1)
+	g.AddEdge(2, 3, 1)
+
+	q, err := g.Modularity(map[int]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(q-(6.0/7-0.5)) > 1e-12 {
+		t.Fatalf("Invalid modularity: %v", q)
+	}
+
+	q, _ = g.Modularity(map[int]int{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0})
+	if math.Abs(q) > 1e-12 {
+		t.Fatalf("Invalid modularity of a single community: %v", q)
+	}
+
+	if _, err := g.Modularity(map[int]int{0: 0}); !errors.Is(err, ErrInvalidPartition) {
+		t.Fatalf("Expected ErrInvalidPartition, got %v", err)
+	}
+	if _, err := g.Modularity(map[int]int{42: 0}); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatalf("Expected ErrNodeNotFound, got %v", err)
+	}
+}
+
+func TestModularityDirected(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 0, 1)
+
+	q, err := g.Modularity(map[int]int{0: 0, 1: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(q+0.5) > 1e-12 {
+		t.Fatalf("Invalid modularity: %v", q)
+	}
+}
+
+func TestCommunitiesBarbell(t *testing.T) {
+	g := BarbellGraph(5)
+	expected := [][]int{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}}
+
+	for name, detect := range map[string]func(CommunityOptions) (map[int]int, float64, error){
+		"Louvain": g.CommunitiesLouvain,
+		"Leiden":  g.CommunitiesLeiden,
+	} {
+		for seed := range int64(5) {
+			partition, q, err := detect(CommunityOptions{Seed: seed})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := communities(partition)
+			for i := range c {
+				slices.Sort(c[i])
+			}
+			slices.SortFunc(c, func(a, b []int) int { return a[0] - b[0] })
+			if !slices.EqualFunc(c, expected, slices.Equal) {
+				t.Fatalf("%s: invalid communities %v", name, c)
+			}
+
+			expectedQ, _ := g.Modularity(partition)
+			if math.Abs(q-expectedQ) > 1e-12 {
+				t.Fatalf("%s: invalid modularity %v, expected %v", name, q, expectedQ)
+			}
+		}
+	}
+}
+
+func TestCommunitiesDirected(t *testing.T) {
+	// Two directed triangles connected by a single edge
+	directed := NewDirectedGraph[int, int]()
+	undirected := NewUndirectedGraph[int, int]()
+	for i := range 6 {
+		directed.AddNode(i)
+		undirected.AddNode(i)
+	}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}, {2, 3}} {
+		directed.AddEdge(e[0], e[1], 1)
+		undirected.AddEdge(e[0], e[1], 1)
+	}
+
+	for name, detect := range map[string]func(CommunityOptions) (map[int]int, float64, error){
+		"Louvain": directed.CommunitiesLouvain,
+		"Leiden":  directed.CommunitiesLeiden,
+	} {
+		partition, q, err := detect(CommunityOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(communities(partition)) != 2 || partition[0] != partition[2] || partition[2] == partition[3] {
+			t.Fatalf("%s: invalid communities %v", name, partition)
+		}
+
+		// The communities are found with edge directions ignored, but the
+		// returned modularity is the directed one
+		if math.Abs(q-(6.0/7-24.0/49)) > 1e-12 {
+			t.Fatalf("%s: invalid modularity %v", name, q)
+		}
+		if undirectedQ, _ := undirected.Modularity(partition); math.Abs(undirectedQ-(6.0/7-0.5)) > 1e-12 {
+			t.Fatalf("%s: invalid undirected modularity %v", name, undirectedQ)
+		}
+	}
+}
+
+func TestCommunitiesKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	for seed := range int64(10) {
+		partition, q, err := g.CommunitiesLouvain(CommunityOptions{Seed: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The optimal modularity is about 0.4198
+		if q < 0.39 || q > 0.42 {
+			t.Fatalf("Louvain: unexpected modularity %v", q)
+		}
+		if len(communities(partition)) < 3 {
+			t.Fatalf("Louvain: too few communities: %v", partition)
+		}
+
+		partition, q, err = g.CommunitiesLeiden(CommunityOptions{Seed: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q < 0.39 || q > 0.42 {
+			t.Fatalf("Leiden: unexpected modularity %v", q)
+		}
+		validateConnectedCommunities(t, g, partition)
+	}
+}
+
+func TestCommunitiesRandom(t *testing.T) {
+	for seed := range int64(10) {
+		g := RandomGraph(false, 200, 600, 1, 10, seed)
+
+		louvain, ql, err := g.CommunitiesLouvain(CommunityOptions{Seed: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+		leiden, qd, err := g.CommunitiesLeiden(CommunityOptions{Seed: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(louvain) != 200 || len(leiden) != 200 {
+			t.Fatal("Partition does not cover all nodes")
+		}
+		if ql <= 0 || qd <= 0 {
+			t.Fatalf("Expected positive modularities, got %v and %v", ql, qd)
+		}
+		validateConnectedCommunities(t, g, leiden)
+
+		// The same seed gives the same partition
+		again, _, _ := g.CommunitiesLeiden(CommunityOptions{Seed: seed})
+		if !maps.Equal(leiden, again) {
+			t.Fatal("Partition differs with the same seed")
+		}
+	}
+}
+
+func TestCommunitiesResolution(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	partition, _, err := g.CommunitiesLouvain(CommunityOptions{Resolution: 0.01})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(communities(partition)); n != 1 {
+		t.Fatalf("Expected a single community, got %d", n)
+	}
+
+	partition, _, _ = g.CommunitiesLeiden(CommunityOptions{Resolution: 3})
+	if n := len(communities(partition)); n < 5 {
+		t.Fatalf("Expected many communities, got %d", n)
+	}
+}
+
+func TestCommunitiesNegativeWeight(t *testing.T) {
+	g := NewUndirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, -1)
+
+	if _, _, err := g.CommunitiesLouvain(CommunityOptions{}); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+	if _, _, err := g.CommunitiesLeiden(CommunityOptions{}); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
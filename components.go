@@ -0,0 +1,178 @@
+package edsger
+
+import "slices"
+
+// Returns the connected components of an undirected graph, and a map
+// from each node to the index of its component.
+func (g *Graph[T, N]) ConnectedComponents() ([][]T, map[T]int, error) {
+	if g.directed {
+		return nil, nil, ErrDirected
+	}
+
+	var components [][]T
+	lookup := make(map[T]int, len(g.nodes))
+	for _, root := range g.NodesList() {
+		if _, ok := lookup[root]; ok {
+			continue
+		}
+
+		c := len(components)
+		lookup[root] = c
+		component := []T{root}
+		for i := 0; i < len(component); i++ {
+			for _, e := range g.edges[component[i]] {
+				if _, ok := lookup[e.Node]; !ok {
+					lookup[e.Node] = c
+					component = append(component, e.Node)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components, lookup, nil
+}
+
+// Returns the weakly connected components of a directed graph, and a map
+// from each node to the index of its component.
+func (g *Graph[T, N]) WeaklyConnectedComponents() ([][]T, map[T]int, error) {
+	if !g.directed {
+		return nil, nil, ErrNotDirected
+	}
+
+	uf := newUnionFind(len(g.nodes))
+	for src, edges := range g.edges {
+		for _, e := range edges {
+			uf.union(g.nodes[src], g.nodes[e.Node])
+		}
+	}
+
+	var components [][]T
+	lookup := make(map[T]int, len(g.nodes))
+	index := make(map[int]int)
+	for i, n := range g.NodesList() {
+		root := uf.find(i)
+		c, ok := index[root]
+		if !ok {
+			c = len(components)
+			index[root] = c
+			components = append(components, nil)
+		}
+		components[c] = append(components[c], n)
+		lookup[n] = c
+	}
+	return components, lookup, nil
+}
+
+// Returns the strongly connected components of a directed graph, and a map
+// from each node to the index of its component. Components are computed using
+// an iterative variant of Tarjan's algorithm and are returned in topological
+// order, i.e. edges between components only go from lower to higher indices.
+func (g *Graph[T, N]) StronglyConnectedComponents() ([][]T, map[T]int, error) {
+	if !g.directed {
+		return nil, nil, ErrNotDirected
+	}
+
+	type frame struct {
+		node T
+		edge int
+	}
+
+	index := make(map[T]int, len(g.nodes))
+	lowlink := make(map[T]int, len(g.nodes))
+	onStack := make(map[T]bool)
+	var stack []T
+	var components [][]T
+
+	for _, root := range g.NodesList() {
+		if _, ok := index[root]; ok {
+			continue
+		}
+
+		callStack := []frame{{node: root}}
+		index[root], lowlink[root] = len(index), len(index)
+		stack = append(stack, root)
+		onStack[root] = true
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			u := top.node
+			if top.edge < len(g.edges[u]) {
+				v := g.edges[u][top.edge].Node
+				top.edge++
+				if _, ok := index[v]; !ok {
+					// Recurse into v
+					index[v], lowlink[v] = len(index), len(index)
+					stack = append(stack, v)
+					onStack[v] = true
+					callStack = append(callStack, frame{node: v})
+				} else if onStack[v] {
+					lowlink[u] = min(lowlink[u], index[v])
+				}
+				continue
+			}
+
+			// All successors of u have been visited
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				lowlink[parent] = min(lowlink[parent], lowlink[u])
+			}
+
+			if lowlink[u] == index[u] {
+				var component []T
+				for {
+					v := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[v] = false
+					component = append(component, v)
+					if v == u {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+		}
+	}
+
+	// Tarjan's algorithm finds the components in reverse topological order
+	slices.Reverse(components)
+	lookup := make(map[T]int, len(g.nodes))
+	for c, component := range components {
+		for _, n := range component {
+			lookup[n] = c
+		}
+	}
+	return components, lookup, nil
+}
+
+// Returns the condensation of a directed graph, i.e. the DAG obtained by
+// contracting each strongly connected component into a single node. Nodes of
+// the condensation are the component indices returned by
+// StronglyConnectedComponents. The weight of an edge between two components
+// is the minimum weight of the edges between them.
+func (g *Graph[T, N]) Condensation() (*Graph[int, N], map[T]int, error) {
+	components, lookup, err := g.StronglyConnectedComponents()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dag := NewDirectedGraph[int, N]()
+	for c := range components {
+		dag.AddNode(c)
+	}
+	for src, edges := range g.edges {
+		cu := lookup[src]
+		for _, e := range edges {
+			cv := lookup[e.Node]
+			if cu == cv {
+				continue
+			}
+			if w, ok := dag.getEdge(cu, cv); !ok {
+				dag.addEdge(cu, cv, e.Weight)
+			} else if e.Weight < w {
+				dag.UpdateEdge(cu, cv, e.Weight)
+			}
+		}
+	}
+	return dag, lookup, nil
+}
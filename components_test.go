@@ -0,0 +1,131 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func validateComponents[T comparable](t *testing.T, components [][]T, lookup map[T]int, n int) {
+	count := 0
+	for c, component := range components {
+		for _, node := range component {
+			if lookup[node] != c {
+				t.Fatal("Invalid lookup for node", node)
+			}
+			count++
+		}
+	}
+	if count != n || len(lookup) != n {
+		t.Fatal("Invalid number of nodes:", count, len(lookup))
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := NoPathGraph()
+	components, lookup, err := g.ConnectedComponents()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	t.Log(components)
+	if len(components) != 3 {
+		t.Fatal("Invalid number of components:", len(components))
+	}
+	validateComponents(t, components, lookup, g.NumberOfNodes())
+	if lookup[1] != lookup[2] || lookup[1] != lookup[3] || lookup[4] == lookup[5] {
+		t.Fatal("Invalid components:", components)
+	}
+
+	if _, _, err := WikipediaDirectedAcyclicGraph().ConnectedComponents(); !errors.Is(err, ErrDirected) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestWeaklyConnectedComponents(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	components, lookup, err := g.WeaklyConnectedComponents()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if len(components) != 1 {
+		t.Fatal("Invalid number of components:", components)
+	}
+	validateComponents(t, components, lookup, g.NumberOfNodes())
+}
+
+func StronglyConnectedGraph() *Graph[string, int] {
+	// Source: https://en.wikipedia.org/wiki/File:Scc-1.svg
+	g := NewDirectedGraph[string, int]()
+	for _, n := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("b", "e", 1)
+	g.AddEdge("b", "f", 1)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("c", "g", 1)
+	g.AddEdge("d", "c", 1)
+	g.AddEdge("d", "h", 1)
+	g.AddEdge("e", "a", 1)
+	g.AddEdge("e", "f", 1)
+	g.AddEdge("f", "g", 1)
+	g.AddEdge("g", "f", 1)
+	g.AddEdge("h", "d", 1)
+	g.AddEdge("h", "g", 1)
+	return g
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := StronglyConnectedGraph()
+	components, lookup, err := g.StronglyConnectedComponents()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	t.Log(components)
+	validateComponents(t, components, lookup, g.NumberOfNodes())
+
+	expected := [][]string{{"a", "b", "e"}, {"c", "d", "h"}, {"f", "g"}}
+	if len(components) != len(expected) {
+		t.Fatal("Invalid components:", components)
+	}
+	for i, c := range components {
+		slices.Sort(c)
+		if !slices.Equal(c, expected[i]) {
+			t.Fatal("Invalid components:", components)
+		}
+	}
+
+	dag, _, err := g.Condensation()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if dag.NumberOfNodes() != 3 || dag.NumberOfEdges() != 3 || !dag.IsDAG() {
+		t.Fatal("Invalid condensation")
+	}
+	for e := range dag.Edges() {
+		if e.From >= e.To {
+			t.Fatal("Components are not in topological order:", e)
+		}
+	}
+}
+
+func TestStronglyConnectedComponentsDeepGraph(t *testing.T) {
+	// Long cycle resulting in a deep DFS
+	n := 100000
+	g := NewDirectedGraph[int, int]()
+	for i := range n {
+		g.AddNode(i)
+	}
+	for i := range n {
+		g.AddEdge(i, (i+1)%n, 1)
+	}
+
+	components, _, err := g.StronglyConnectedComponents()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if len(components) != 1 {
+		t.Fatal("Invalid number of components:", len(components))
+	}
+}
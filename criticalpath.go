@@ -0,0 +1,85 @@
+package edsger
+
+import "slices"
+
+// Result of the critical path method (CPM). Edge weights are durations and
+// the start times of each node are computed such that all edges are
+// respected.
+type CriticalPath[T comparable, N Number] struct {
+	// Critical path, i.e. longest path of the DAG
+	Path []T
+	// Length of the critical path, i.e. total duration
+	Length N
+
+	EarliestStart map[T]N
+	LatestStart   map[T]N
+	// Difference between the latest and the earliest start of a node. Nodes
+	// on the critical path have no slack.
+	Slack map[T]N
+}
+
+// Returns the longest path of a DAG and its length
+func (g *Graph[T, N]) DAGLongestPath() ([]T, N, error) {
+	cp, err := g.CriticalPathAnalysis()
+	if err != nil {
+		return nil, 0, err
+	}
+	return cp.Path, cp.Length, nil
+}
+
+// Computes the earliest start, latest start and slack of each node of a DAG
+// using the critical path method
+func (g *Graph[T, N]) CriticalPathAnalysis() (*CriticalPath[T, N], error) {
+	order, err := g.TopologicalOrdering()
+	if err != nil {
+		return nil, err
+	}
+
+	cp := &CriticalPath[T, N]{
+		EarliestStart: make(map[T]N, len(order)),
+		LatestStart:   make(map[T]N, len(order)),
+		Slack:         make(map[T]N, len(order)),
+	}
+	if len(order) == 0 {
+		return cp, nil
+	}
+
+	// Forward pass computing the earliest start of each node
+	prev := make(map[T]T, len(order))
+	for _, u := range order {
+		if _, ok := cp.EarliestStart[u]; !ok {
+			cp.EarliestStart[u] = 0
+		}
+		for _, e := range g.edges[u] {
+			alt := cp.EarliestStart[u] + e.Weight
+			if es, ok := cp.EarliestStart[e.Node]; !ok || alt > es {
+				cp.EarliestStart[e.Node] = alt
+				prev[e.Node] = u
+			}
+		}
+	}
+
+	last := order[0]
+	for _, u := range order {
+		if cp.EarliestStart[u] > cp.EarliestStart[last] {
+			last = u
+		}
+	}
+	cp.Length = cp.EarliestStart[last]
+	cp.Path = []T{last}
+	for v, ok := prev[last]; ok; v, ok = prev[v] {
+		cp.Path = append(cp.Path, v)
+	}
+	slices.Reverse(cp.Path)
+
+	// Backward pass computing the latest start of each node
+	for _, u := range slices.Backward(order) {
+		ls := cp.Length
+		for _, e := range g.edges[u] {
+			ls = min(ls, cp.LatestStart[e.Node]-e.Weight)
+		}
+		cp.LatestStart[u] = ls
+		cp.Slack[u] = ls - cp.EarliestStart[u]
+	}
+	return cp, nil
+}
@@ -0,0 +1,73 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func BuildPipelineGraph() *Graph[string, int] {
+	// Edge weights are the durations of the tasks of the source nodes
+	g := NewDirectedGraph[string, int]()
+	for _, n := range []string{"checkout", "deps", "lint", "build", "test", "package", "deploy"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("checkout", "deps", 1)
+	g.AddEdge("checkout", "lint", 1)
+	g.AddEdge("deps", "build", 5)
+	g.AddEdge("lint", "package", 2)
+	g.AddEdge("build", "test", 10)
+	g.AddEdge("build", "package", 10)
+	g.AddEdge("test", "deploy", 4)
+	g.AddEdge("package", "deploy", 3)
+	return g
+}
+
+func TestDAGLongestPath(t *testing.T) {
+	g := BuildPipelineGraph()
+	path, length, err := g.DAGLongestPath()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if length != 20 || !slices.Equal(path, []string{"checkout", "deps", "build", "test", "deploy"}) {
+		t.Fatal("Invalid path:", path, length)
+	}
+	validatePath(t, g, path, length)
+
+	if _, _, err := WikipediaGraph().DAGLongestPath(); !errors.Is(err, ErrNotDirected) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestCriticalPathAnalysis(t *testing.T) {
+	g := BuildPipelineGraph()
+	cp, err := g.CriticalPathAnalysis()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	expected := map[string][3]int{
+		"checkout": {0, 0, 0},
+		"deps":     {1, 1, 0},
+		"lint":     {1, 15, 14},
+		"build":    {6, 6, 0},
+		"test":     {16, 16, 0},
+		"package":  {16, 17, 1},
+		"deploy":   {20, 20, 0},
+	}
+	for n, e := range expected {
+		if cp.EarliestStart[n] != e[0] || cp.LatestStart[n] != e[1] || cp.Slack[n] != e[2] {
+			t.Fatal("Invalid result for node", n, cp.EarliestStart[n], cp.LatestStart[n], cp.Slack[n])
+		}
+	}
+	for _, n := range cp.Path {
+		if cp.Slack[n] != 0 {
+			t.Fatal("Node on the critical path has slack:", n)
+		}
+	}
+
+	g.AddEdge("deploy", "checkout", 1)
+	if _, err := g.CriticalPathAnalysis(); !errors.Is(err, ErrCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
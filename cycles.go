@@ -0,0 +1,329 @@
+package edsger
+
+import "slices"
+
+// Returns a cycle of the graph, or nil if the graph is acyclic. The returned
+// cycle starts and ends with the same node.
+func (g *Graph[T, N]) FindCycle() []T {
+	type frame struct {
+		node T
+		edge int
+	}
+
+	// Nodes on the DFS stack are marked in gray and finished nodes in black
+	const gray, black = 1, 2
+	color := make(map[T]int, len(g.nodes))
+	for _, root := range g.NodesList() {
+		if color[root] != 0 {
+			continue
+		}
+
+		color[root] = gray
+		stack := []frame{{node: root}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.edge == len(g.edges[top.node]) {
+				color[top.node] = black
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			v := g.edges[top.node][top.edge].Node
+			top.edge++
+			if !g.directed && len(stack) > 1 && stack[len(stack)-2].node == v {
+				// Undirected edge back to the parent
+				continue
+			}
+
+			switch color[v] {
+			case 0:
+				color[v] = gray
+				stack = append(stack, frame{node: v})
+			case gray:
+				i := slices.IndexFunc(stack, func(f frame) bool { return f.node == v })
+				cycle := make([]T, 0, len(stack)-i+1)
+				for _, f := range stack[i:] {
+					cycle = append(cycle, f.node)
+				}
+				return append(cycle, v)
+			}
+		}
+	}
+	return nil
+}
+
+type cycleFrame[T comparable, N Number] struct {
+	node   T
+	edge   int
+	weight N
+	found  bool
+}
+
+// Iterator over the elementary cycles of a graph. For directed graphs, all
+// elementary cycles are enumerated using Johnson's algorithm. For undirected
+// graphs, the cycles of a cycle basis are returned. Cycles start and end with
+// the same node.
+type CycleIterator[T comparable, N Number] struct {
+	// Maximum weight of a cycle. Edge weights must be non-negative when set.
+	CutoffWeight N
+	// Maximum number of edges of a cycle
+	CutoffHops int
+
+	g *Graph[T, N]
+
+	// State of Johnson's algorithm
+	order        []T
+	predecessors map[T]map[T]bool
+	start        int
+	component    map[T]bool
+	blocked      map[T]bool
+	blockers     map[T]map[T]bool
+	stack        []*cycleFrame[T, N]
+
+	// Cycle basis of undirected graphs
+	basis [][]T
+
+	// Returned value
+	cycle  []T
+	weight N
+}
+
+// Returns an iterator over the elementary cycles of the graph
+func (g *Graph[T, N]) AllCycles() *CycleIterator[T, N] {
+	it := &CycleIterator[T, N]{
+		CutoffWeight: MaxValue[N](),
+		CutoffHops:   MaxInt[int](),
+		g:            g,
+		start:        -1,
+	}
+	if g.directed {
+		it.order = g.NodesList()
+		it.predecessors = g.AllPredecessors()
+	} else {
+		it.basis = g.cycleBasis()
+	}
+	return it
+}
+
+func (it *CycleIterator[T, N]) Next() bool {
+	if !it.g.directed {
+		return it.nextBasisCycle()
+	}
+
+	for {
+		n := len(it.stack)
+		if n == 0 {
+			if !it.nextStart() {
+				it.cycle = nil
+				it.weight = 0
+				return false
+			}
+			continue
+		}
+
+		top := it.stack[n-1]
+		edges := it.g.edges[top.node]
+		if top.edge < len(edges) {
+			e := edges[top.edge]
+			top.edge++
+			if !it.component[e.Node] {
+				continue
+			}
+
+			w := top.weight + e.Weight
+			s := it.order[it.start]
+			if e.Node == s {
+				// Pruned cycles also count as found to keep the blocking
+				// of Johnson's algorithm correct
+				top.found = true
+				if n <= it.CutoffHops && w <= it.CutoffWeight {
+					it.cycle = make([]T, n+1)
+					for i, f := range it.stack {
+						it.cycle[i] = f.node
+					}
+					it.cycle[n] = s
+					it.weight = w
+					return true
+				}
+			} else if !it.blocked[e.Node] {
+				if n >= it.CutoffHops || w > it.CutoffWeight {
+					top.found = true
+					continue
+				}
+				it.blocked[e.Node] = true
+				it.stack = append(it.stack, &cycleFrame[T, N]{node: e.Node, weight: w})
+			}
+			continue
+		}
+
+		// All successors of the node have been explored
+		it.stack = it.stack[:n-1]
+		if top.found {
+			it.unblock(top.node)
+			if n > 1 {
+				it.stack[n-2].found = true
+			}
+		} else {
+			for _, e := range edges {
+				if it.component[e.Node] {
+					if it.blockers[e.Node] == nil {
+						it.blockers[e.Node] = make(map[T]bool)
+					}
+					it.blockers[e.Node][top.node] = true
+				}
+			}
+		}
+	}
+}
+
+func (it *CycleIterator[T, N]) unblock(node T) {
+	q := []T{node}
+	for len(q) > 0 {
+		u := q[len(q)-1]
+		q = q[:len(q)-1]
+		it.blocked[u] = false
+		for w := range it.blockers[u] {
+			if it.blocked[w] {
+				q = append(q, w)
+			}
+		}
+		delete(it.blockers, u)
+	}
+}
+
+// Moves to the next start node having a strongly connected component with a
+// cycle in the subgraph induced by the nodes not yet used as start nodes
+func (it *CycleIterator[T, N]) nextStart() bool {
+	g := it.g
+	for it.start++; it.start < len(it.order); it.start++ {
+		s := it.order[it.start]
+		allowed := func(n T) bool {
+			return g.nodes[n] >= it.start
+		}
+
+		// Nodes reachable from s and reaching s
+		forward := map[T]bool{s: true}
+		q := []T{s}
+		for len(q) > 0 {
+			u := q[len(q)-1]
+			q = q[:len(q)-1]
+			for _, e := range g.edges[u] {
+				if allowed(e.Node) && !forward[e.Node] {
+					forward[e.Node] = true
+					q = append(q, e.Node)
+				}
+			}
+		}
+
+		it.component = map[T]bool{s: true}
+		q = []T{s}
+		for len(q) > 0 {
+			u := q[len(q)-1]
+			q = q[:len(q)-1]
+			for src := range it.predecessors[u] {
+				if forward[src] && !it.component[src] {
+					it.component[src] = true
+					q = append(q, src)
+				}
+			}
+		}
+		if _, selfLoop := g.getEdge(s, s); len(it.component) == 1 && !selfLoop {
+			continue
+		}
+
+		it.blocked = map[T]bool{s: true}
+		it.blockers = make(map[T]map[T]bool)
+		it.stack = []*cycleFrame[T, N]{{node: s}}
+		return true
+	}
+	return false
+}
+
+func (it *CycleIterator[T, N]) nextBasisCycle() bool {
+	for len(it.basis) > 0 {
+		cycle := it.basis[0]
+		it.basis = it.basis[1:]
+		if len(cycle)-1 > it.CutoffHops {
+			continue
+		}
+
+		var w N
+		for i := range len(cycle) - 1 {
+			ew, _ := it.g.getEdge(cycle[i], cycle[i+1])
+			w += ew
+		}
+		if w > it.CutoffWeight {
+			continue
+		}
+
+		it.cycle = cycle
+		it.weight = w
+		return true
+	}
+
+	it.cycle = nil
+	it.weight = 0
+	return false
+}
+
+func (it *CycleIterator[T, N]) Get() ([]T, N) {
+	return it.cycle, it.weight
+}
+
+// Returns a cycle basis of an undirected graph. Each edge which is not part
+// of a BFS spanning forest closes one cycle of the basis.
+func (g *Graph[T, N]) cycleBasis() [][]T {
+	parent := make(map[T]T, len(g.nodes))
+	depth := make(map[T]int, len(g.nodes))
+	seen := make(map[edgeKey[T]]bool)
+	var nonTree [][2]T
+	for _, root := range g.NodesList() {
+		if _, ok := depth[root]; ok {
+			continue
+		}
+
+		depth[root] = 0
+		q := []T{root}
+		for len(q) > 0 {
+			u := q[0]
+			q = q[1:]
+			for _, e := range g.edges[u] {
+				v := e.Node
+				if _, ok := depth[v]; !ok {
+					depth[v] = depth[u] + 1
+					parent[v] = u
+					q = append(q, v)
+				} else if u == v || (parent[v] != u && parent[u] != v) {
+					// Each non-tree edge is seen from both of its ends
+					key := edgeKey[T]{u, v}
+					if g.nodes[u] > g.nodes[v] {
+						key = edgeKey[T]{v, u}
+					}
+					if !seen[key] {
+						seen[key] = true
+						nonTree = append(nonTree, [2]T{key.from, key.to})
+					}
+				}
+			}
+		}
+	}
+
+	basis := make([][]T, 0, len(nonTree))
+	for _, e := range nonTree {
+		// Walk up the BFS tree from both ends until the paths meet
+		u, v := e[0], e[1]
+		left, right := []T{u}, []T{v}
+		for u != v {
+			if depth[u] >= depth[v] {
+				u = parent[u]
+				left = append(left, u)
+			} else {
+				v = parent[v]
+				right = append(right, v)
+			}
+		}
+		slices.Reverse(right)
+		basis = append(basis, slices.Concat(left, right[1:], []T{e[0]}))
+	}
+	return basis
+}
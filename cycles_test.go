@@ -0,0 +1,175 @@
+package edsger
+
+import (
+	"slices"
+	"testing"
+)
+
+func validateCycle[T comparable, N Number](t *testing.T, g *Graph[T, N], cycle []T) N {
+	if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatal("Invalid cycle:", cycle)
+	}
+	nodes := make(map[T]bool)
+	for _, n := range cycle[1:] {
+		if nodes[n] {
+			t.Fatal("Cycle is not elementary:", cycle)
+		}
+		nodes[n] = true
+	}
+
+	var total N
+	for i := range len(cycle) - 1 {
+		w, ok := g.GetEdge(cycle[i], cycle[i+1])
+		if !ok {
+			t.Fatal("Invalid cycle:", cycle)
+		}
+		total += w
+	}
+	return total
+}
+
+func CompleteDirectedGraph(n int) *Graph[int, int] {
+	g := NewDirectedGraph[int, int]()
+	for i := range n {
+		g.AddNode(i)
+	}
+	for i := range n {
+		for j := range n {
+			if i != j {
+				g.AddEdge(i, j, 1)
+			}
+		}
+	}
+	return g
+}
+
+func TestFindCycle(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	if cycle := g.FindCycle(); cycle != nil {
+		t.Fatal("Unexpected cycle:", cycle)
+	}
+
+	g.AddEdge(10, 5, 0)
+	cycle := g.FindCycle()
+	t.Log(cycle)
+	validateCycle(t, g, cycle)
+
+	g = WikipediaGraph()
+	cycle = g.FindCycle()
+	t.Log(cycle)
+	validateCycle(t, g, cycle)
+	if len(cycle) < 4 {
+		t.Fatal("Invalid cycle:", cycle)
+	}
+
+	if cycle := NoPathGraph().FindCycle(); cycle != nil {
+		t.Fatal("Unexpected cycle:", cycle)
+	}
+}
+
+func TestAllCyclesDirected(t *testing.T) {
+	g := CompleteDirectedGraph(4)
+
+	count := func(it *CycleIterator[int, int]) int {
+		var cycles [][]int
+		for it.Next() {
+			cycle, weight := it.Get()
+			if validateCycle(t, g, cycle) != weight {
+				t.Fatal("Invalid weight:", cycle, weight)
+			}
+			if len(cycle)-1 > it.CutoffHops || weight > it.CutoffWeight {
+				t.Fatal("Cutoff exceeded:", cycle, weight)
+			}
+			for _, c := range cycles {
+				if slices.Equal(c, cycle) {
+					t.Fatal("Duplicate cycle:", cycle)
+				}
+			}
+			cycles = append(cycles, cycle)
+		}
+		return len(cycles)
+	}
+
+	// 6 cycles of length 2, 8 of length 3 and 6 of length 4
+	if n := count(g.AllCycles()); n != 20 {
+		t.Fatal("Invalid number of cycles:", n)
+	}
+
+	it := g.AllCycles()
+	it.CutoffHops = 2
+	if n := count(it); n != 6 {
+		t.Fatal("Invalid number of cycles:", n)
+	}
+
+	it = g.AllCycles()
+	it.CutoffWeight = 3
+	if n := count(it); n != 14 {
+		t.Fatal("Invalid number of cycles:", n)
+	}
+
+	g.AddEdge(0, 0, 1)
+	if n := count(g.AllCycles()); n != 21 {
+		t.Fatal("Invalid number of cycles:", n)
+	}
+
+	if WikipediaDirectedAcyclicGraph().AllCycles().Next() {
+		t.Fatal("Unexpected cycle")
+	}
+}
+
+func TestAllCyclesUndirected(t *testing.T) {
+	g := WikipediaGraph()
+	n := 0
+	it := g.AllCycles()
+	for it.Next() {
+		cycle, weight := it.Get()
+		t.Log(cycle, weight)
+		if validateCycle(t, g, cycle) != weight {
+			t.Fatal("Invalid weight:", cycle, weight)
+		}
+		n++
+	}
+
+	// Number of cycles in a cycle basis
+	if n != g.NumberOfEdges()-g.NumberOfNodes()+1 {
+		t.Fatal("Invalid number of cycles:", n)
+	}
+}
+
+// Counts elementary cycles by enumerating paths from the smallest node of each cycle
+func bruteForceCycleCount(g *Graph[int, int], maxHops int) int {
+	count := 0
+	var dfs func(s, u, hops int, visited map[int]bool)
+	dfs = func(s, u, hops int, visited map[int]bool) {
+		for v := range g.Successors(u) {
+			if v == s && hops+1 <= maxHops {
+				count++
+			} else if v > s && !visited[v] && hops+1 < maxHops {
+				visited[v] = true
+				dfs(s, v, hops+1, visited)
+				visited[v] = false
+			}
+		}
+	}
+	for s := range g.Nodes() {
+		dfs(s, s, 0, map[int]bool{s: true})
+	}
+	return count
+}
+
+func TestAllCyclesRandomGraphs(t *testing.T) {
+	for seed := range int64(5) {
+		g := RandomGraph(true, 12, 30, 1, 1, seed)
+		for _, hops := range []int{3, MaxInt[int]()} {
+			it := g.AllCycles()
+			it.CutoffHops = hops
+			n := 0
+			for it.Next() {
+				n++
+			}
+			if expected := bruteForceCycleCount(g, hops); n != expected {
+				t.Fatalf("Invalid number of cycles: %d (expected %d)", n, expected)
+			}
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package edsger
 
-import "errors"
+import (
+	"container/heap"
+	"slices"
+)
 
 // Implementation using Kahn's algorithm
 func (g *Graph[T, N]) TopologicalOrdering() ([]T, error) {
 	if !g.directed {
-		return nil, errors.New("Graph is not directed")
+		return nil, ErrNotDirected
 	}
 
 	allPredecessors := g.AllPredecessors()
@@ -46,7 +49,7 @@ func (g *Graph[T, N]) TopologicalOrdering() ([]T, error) {
 	}
 
 	if len(allSuccessors) > 0 {
-		return nil, errors.New("Graph contains at least one cycle")
+		return nil, ErrCycle
 	}
 	return res, nil
 }
@@ -55,3 +58,195 @@ func (g *Graph[T, N]) IsDAG() bool {
 	_, err := g.TopologicalOrdering()
 	return err == nil
 }
+
+// Returns the number of incoming edges of each node
+func (g *Graph[T, N]) inDegrees() map[T]int {
+	res := make(map[T]int, len(g.nodes))
+	for n := range g.nodes {
+		res[n] = 0
+	}
+	for _, edges := range g.edges {
+		for _, e := range edges {
+			res[e.Node]++
+		}
+	}
+	return res
+}
+
+// Min-heap of nodes ordered by a comparison function
+type nodeHeap[T comparable] struct {
+	nodes []T
+	cmp   func(a, b T) int
+}
+
+func (h *nodeHeap[T]) Len() int           { return len(h.nodes) }
+func (h *nodeHeap[T]) Less(i, j int) bool { return h.cmp(h.nodes[i], h.nodes[j]) < 0 }
+func (h *nodeHeap[T]) Swap(i, j int)      { h.nodes[i], h.nodes[j] = h.nodes[j], h.nodes[i] }
+func (h *nodeHeap[T]) Push(x any)         { h.nodes = append(h.nodes, x.(T)) }
+func (h *nodeHeap[T]) Pop() any {
+	n := len(h.nodes)
+	x := h.nodes[n-1]
+	h.nodes = h.nodes[:n-1]
+	return x
+}
+
+// Deterministic variant of TopologicalOrdering. Among all nodes without
+// remaining predecessors, the smallest one according to cmp is picked first,
+// resulting in the lexicographically smallest topological ordering.
+func (g *Graph[T, N]) TopologicalOrderingFunc(cmp func(a, b T) int) ([]T, error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	indegree := g.inDegrees()
+	h := &nodeHeap[T]{cmp: cmp}
+	for n, d := range indegree {
+		if d == 0 {
+			h.nodes = append(h.nodes, n)
+		}
+	}
+	heap.Init(h)
+
+	res := make([]T, 0, len(g.nodes))
+	for h.Len() > 0 {
+		n := heap.Pop(h).(T)
+		res = append(res, n)
+		for _, e := range g.edges[n] {
+			indegree[e.Node]--
+			if indegree[e.Node] == 0 {
+				heap.Push(h, e.Node)
+			}
+		}
+	}
+
+	if len(res) != len(g.nodes) {
+		return nil, ErrCycle
+	}
+	return res, nil
+}
+
+// Returns the nodes of a DAG grouped by generation. Nodes of the first
+// generation have no predecessors, and nodes of the following generations
+// only have predecessors in previous generations, such that all nodes of a
+// generation can be processed in parallel. Nodes of each generation are
+// ordered by insertion order.
+func (g *Graph[T, N]) TopologicalGenerations() ([][]T, error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	indegree := g.inDegrees()
+	var generation []T
+	for _, n := range g.NodesList() {
+		if indegree[n] == 0 {
+			generation = append(generation, n)
+		}
+	}
+
+	var res [][]T
+	count := 0
+	for len(generation) > 0 {
+		res = append(res, generation)
+		count += len(generation)
+
+		var next []T
+		for _, n := range generation {
+			for _, e := range g.edges[n] {
+				indegree[e.Node]--
+				if indegree[e.Node] == 0 {
+					next = append(next, e.Node)
+				}
+			}
+		}
+		slices.SortFunc(next, func(a, b T) int {
+			return g.nodes[a] - g.nodes[b]
+		})
+		generation = next
+	}
+
+	if count != len(g.nodes) {
+		return nil, ErrCycle
+	}
+	return res, nil
+}
+
+type topologicalFrame[T comparable] struct {
+	ready []T
+	i     int
+}
+
+// Iterator over all topological orderings of a DAG
+type TopologicalOrderingIterator[T comparable, N Number] struct {
+	g        *Graph[T, N]
+	indegree map[T]int
+	stack    []*topologicalFrame[T]
+	order    []T
+}
+
+// Returns an iterator over all topological orderings of a DAG, enumerated by
+// backtracking over the nodes without remaining predecessors
+func (g *Graph[T, N]) AllTopologicalOrderings() (*TopologicalOrderingIterator[T, N], error) {
+	if _, err := g.TopologicalOrdering(); err != nil {
+		return nil, err
+	}
+
+	indegree := g.inDegrees()
+	var ready []T
+	for _, n := range g.NodesList() {
+		if indegree[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+	return &TopologicalOrderingIterator[T, N]{
+		g:        g,
+		indegree: indegree,
+		stack:    []*topologicalFrame[T]{{ready: ready, i: -1}},
+		order:    make([]T, 0, len(g.nodes)),
+	}, nil
+}
+
+func (it *TopologicalOrderingIterator[T, N]) Next() bool {
+	if len(it.g.nodes) == 0 && len(it.stack) > 0 {
+		// The empty graph has exactly one ordering
+		it.stack = nil
+		return true
+	}
+	for len(it.stack) > 0 {
+		top := it.stack[len(it.stack)-1]
+		if top.i >= 0 {
+			// Undo the previous choice
+			n := top.ready[top.i]
+			for _, e := range it.g.edges[n] {
+				it.indegree[e.Node]++
+			}
+			it.order = it.order[:len(it.order)-1]
+		}
+
+		top.i++
+		if top.i == len(top.ready) {
+			it.stack = it.stack[:len(it.stack)-1]
+			continue
+		}
+
+		n := top.ready[top.i]
+		it.order = append(it.order, n)
+		ready := slices.Concat(top.ready[:top.i], top.ready[top.i+1:])
+		for _, e := range it.g.edges[n] {
+			it.indegree[e.Node]--
+			if it.indegree[e.Node] == 0 {
+				ready = append(ready, e.Node)
+			}
+		}
+		if len(it.order) == len(it.g.nodes) {
+			return true
+		}
+		it.stack = append(it.stack, &topologicalFrame[T]{ready: ready, i: -1})
+	}
+
+	it.order = nil
+	return false
+}
+
+func (it *TopologicalOrderingIterator[T, N]) Get() []T {
+	return slices.Clone(it.order)
+}
@@ -1,6 +1,11 @@
 package edsger
 
-import "testing"
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+)
 
 func TestTopologicalOrdering(t *testing.T) {
 	g := WikipediaDirectedAcyclicGraph()
@@ -11,7 +16,132 @@ func TestTopologicalOrdering(t *testing.T) {
 
 	g.AddEdge(10, 5, 0)
 	_, err = g.TopologicalOrdering()
-	if err == nil {
+	if !errors.Is(err, ErrCycle) {
 		t.Fatal("Invalid result")
 	}
+
+	_, err = WikipediaGraph().TopologicalOrdering()
+	if !errors.Is(err, ErrNotDirected) {
+		t.Fatal("Invalid result")
+	}
+}
+
+func validateTopologicalOrdering(t *testing.T, g *Graph[int, int], order []int) {
+	if len(order) != g.NumberOfNodes() {
+		t.Fatal("Invalid ordering:", order)
+	}
+	position := make(map[int]int, len(order))
+	for i, n := range order {
+		position[n] = i
+	}
+	for e := range g.Edges() {
+		if position[e.From] >= position[e.To] {
+			t.Fatal("Invalid ordering:", order)
+		}
+	}
+}
+
+func TestTopologicalOrderingFunc(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	for range 10 {
+		order, err := g.TopologicalOrderingFunc(cmp.Compare[int])
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if !slices.Equal(order, []int{3, 5, 7, 8, 11, 2, 9, 10}) {
+			t.Fatal("Invalid ordering:", order)
+		}
+	}
+
+	order, err := g.TopologicalOrderingFunc(func(a, b int) int { return b - a })
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if !slices.Equal(order, []int{7, 5, 11, 3, 10, 8, 9, 2}) {
+		t.Fatal("Invalid ordering:", order)
+	}
+
+	g.AddEdge(10, 5, 0)
+	if _, err := g.TopologicalOrderingFunc(cmp.Compare[int]); !errors.Is(err, ErrCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestTopologicalGenerations(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	generations, err := g.TopologicalGenerations()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	expected := [][]int{{3, 5, 7}, {8, 11}, {2, 9, 10}}
+	if !slices.EqualFunc(generations, expected, slices.Equal) {
+		t.Fatal("Invalid generations:", generations)
+	}
+
+	g.AddEdge(10, 5, 0)
+	if _, err := g.TopologicalGenerations(); !errors.Is(err, ErrCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestAllTopologicalOrderings(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 0)
+	g.AddEdge(0, 2, 0)
+	g.AddEdge(1, 3, 0)
+	g.AddEdge(2, 3, 0)
+
+	it, err := g.AllTopologicalOrderings()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	var orders [][]int
+	for it.Next() {
+		order := it.Get()
+		validateTopologicalOrdering(t, g, order)
+		orders = append(orders, order)
+	}
+	if !slices.EqualFunc(orders, [][]int{{0, 1, 2, 3}, {0, 2, 1, 3}}, slices.Equal) {
+		t.Fatal("Invalid orderings:", orders)
+	}
+
+	// Nodes without edges can be ordered arbitrarily
+	g = NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	it, _ = g.AllTopologicalOrderings()
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if n != 24 {
+		t.Fatal("Invalid number of orderings:", n)
+	}
+
+	n = 0
+	it, _ = WikipediaDirectedAcyclicGraph().AllTopologicalOrderings()
+	for it.Next() {
+		validateTopologicalOrdering(t, WikipediaDirectedAcyclicGraph(), it.Get())
+		n++
+	}
+	t.Log(n, "orderings")
+}
+
+func TestAllTopologicalOrderingsEmptyGraph(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	it, err := g.AllTopologicalOrderings()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if !it.Next() || len(it.Get()) != 0 {
+		t.Fatal("Expected the empty ordering")
+	}
+	if it.Next() {
+		t.Fatal("Expected a single ordering")
+	}
 }
@@ -18,6 +18,8 @@ type priorityItem[T comparable, N Number] struct {
 type priorityQueue[T comparable, N Number] struct {
 	items []*priorityItem[T, N]
 	m     map[T]*priorityItem[T, N]
+	// Pops the item with the highest priority first
+	reverse bool
 }
 
 func newPriorityQueue[T comparable, N Number](n int) *priorityQueue[T, N] {
@@ -32,6 +34,9 @@ func (pq *priorityQueue[T, N]) Len() int { return len(pq.items) }
 
 // Implements sort.Interface
 func (pq *priorityQueue[T, N]) Less(i, j int) bool {
+	if pq.reverse {
+		return pq.items[i].prio > pq.items[j].prio
+	}
 	return pq.items[i].prio < pq.items[j].prio
 }
 
@@ -75,11 +80,13 @@ func (pq *priorityQueue[T, N]) update(pi *priorityItem[T, N], priority N) {
 	}
 }
 
-// Implementation of Dijkstra's shortest path algorithm using a priority queue
-func (g *Graph[T, N]) sourceShortestPathMap(source T, withMultiplePaths bool, excludedNodes map[T]bool) map[T][]T {
+// Implementation of Dijkstra's shortest path algorithm using a priority queue.
+// Returns the predecessors and distances of all nodes reachable from source.
+func (g *Graph[T, N]) sourceShortestPathMap(source T, withMultiplePaths bool, excludedNodes map[T]bool) (map[T][]T, map[T]N, error) {
 	L := g.NumberOfNodes() - len(excludedNodes)
 	maxW := MaxValue[N]()
 	prev := make(map[T][]T, L)
+	dist := make(map[T]N, L)
 
 	// Manually initialize the priority queue
 	q := newPriorityQueue[T, N](L)
@@ -93,6 +100,9 @@ func (g *Graph[T, N]) sourceShortestPathMap(source T, withMultiplePaths bool, ex
 
 	for q.Len() > 0 {
 		u := heap.Pop(q).(*priorityItem[T, N])
+		if u.prio != maxW {
+			dist[u.node] = u.prio
+		}
 
 		for _, v := range g.Neighbors(u.node) {
 			if _, ok := excludedNodes[v.Node]; ok {
@@ -101,7 +111,7 @@ func (g *Graph[T, N]) sourceShortestPathMap(source T, withMultiplePaths bool, ex
 
 			var alt N
 			if v.Weight < 0 {
-				panic(fmt.Sprintf("Edge (%v, %v) has a negative weight!", u, v.Node))
+				return nil, nil, fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, u.node, v.Node)
 			} else if u.prio == maxW {
 				// We prevent here any integer overflow
 				alt = maxW
@@ -121,12 +131,19 @@ func (g *Graph[T, N]) sourceShortestPathMap(source T, withMultiplePaths bool, ex
 		}
 	}
 
-	return prev
+	return prev, dist, nil
+}
+
+// Directed edge used as a map key
+type edgeKey[T comparable] struct {
+	from, to T
 }
 
 // Implementation of Dijkstra's shortest path algorithm using a priority queue
-func (g *Graph[T, N]) shortestPathMap(source, dest T, withMultiplePaths bool, excludedNodes map[T]bool) (map[T][]T, N) {
-	g.validatePathNodes(source, dest)
+func (g *Graph[T, N]) shortestPathMap(source, dest T, withMultiplePaths bool, excludedNodes map[T]bool, excludedEdges map[edgeKey[T]]bool) (map[T][]T, N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, 0, err
+	}
 
 	L := g.NumberOfNodes() - len(excludedNodes)
 	maxW := MaxValue[N]()
@@ -152,10 +169,13 @@ func (g *Graph[T, N]) shortestPathMap(source, dest T, withMultiplePaths bool, ex
 			if _, ok := excludedNodes[v.Node]; ok {
 				continue
 			}
+			if excludedEdges[edgeKey[T]{u.node, v.Node}] {
+				continue
+			}
 
 			var alt N
 			if v.Weight < 0 {
-				panic(fmt.Sprintf("Edge (%v, %v) has a negative weight!", u, v.Node))
+				return nil, 0, fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, u.node, v.Node)
 			} else if u.prio == maxW {
 				// We prevent here any integer overflow
 				alt = maxW
@@ -171,6 +191,13 @@ func (g *Graph[T, N]) shortestPathMap(source, dest T, withMultiplePaths bool, ex
 			} else if withMultiplePaths && alt == pi.prio {
 				prev[v.Node] = append(prev[v.Node], u.node)
 				q.update(pi, alt)
+
+			} else if alt == pi.prio && pi.index >= 0 {
+				// Ties are broken using the node index such that the
+				// returned path does not depend on the settling order
+				if p, ok := prev[v.Node]; ok && g.nodes[u.node] < g.nodes[p[0]] {
+					prev[v.Node] = []T{u.node}
+				}
 			}
 		}
 	}
@@ -178,9 +205,9 @@ func (g *Graph[T, N]) shortestPathMap(source, dest T, withMultiplePaths bool, ex
 	d, ok := q.m[dest]
 	if !ok || d.prio == maxW {
 		// No path was found
-		return nil, maxW
+		return nil, maxW, nil
 	}
-	return prev, d.prio
+	return prev, d.prio, nil
 }
 
 func pathFromShortestPathMap[T comparable, N Number](dest T, prev map[T][]T, dist N) ([]T, N) {
@@ -203,27 +230,56 @@ func pathFromShortestPathMap[T comparable, N Number](dest T, prev map[T][]T, dis
 	return path, dist
 }
 
+// Computes a shortest path from source to dest using Dijkstra's algorithm.
+// When several shortest paths exist, each node of the path is preceded by the
+// candidate predecessor which was added first to the graph.
 func (g *Graph[T, N]) DijkstraShortestPath(source, dest T) ([]T, N) {
-	prev, dist := g.shortestPathMap(source, dest, false, nil)
-	return pathFromShortestPathMap(dest, prev, dist)
+	return must2(g.TryDijkstraShortestPath(source, dest))
+}
+
+// Same as DijkstraShortestPath but returns an error instead of panicking
+func (g *Graph[T, N]) TryDijkstraShortestPath(source, dest T) ([]T, N, error) {
+	return g.TryDijkstraShortestPathWithExclusionMap(source, dest, nil)
 }
 
 func (g *Graph[T, N]) DijkstraShortestPathWithExclusionMap(source, dest T, excludedNodes map[T]bool) ([]T, N) {
-	prev, dist := g.shortestPathMap(source, dest, false, excludedNodes)
-	return pathFromShortestPathMap(dest, prev, dist)
+	return must2(g.TryDijkstraShortestPathWithExclusionMap(source, dest, excludedNodes))
+}
+
+// Same as DijkstraShortestPathWithExclusionMap but returns an error instead of panicking
+func (g *Graph[T, N]) TryDijkstraShortestPathWithExclusionMap(source, dest T, excludedNodes map[T]bool) ([]T, N, error) {
+	prev, dist, err := g.shortestPathMap(source, dest, false, excludedNodes, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	path, dist := pathFromShortestPathMap(dest, prev, dist)
+	return path, dist, nil
 }
 
 func (g *Graph[T, N]) AllDijkstraShortestPathsMap(source, dest T) (map[T][]T, N) {
-	return g.shortestPathMap(source, dest, true, nil)
+	return must2(g.TryAllDijkstraShortestPathsMap(source, dest))
+}
+
+// Same as AllDijkstraShortestPathsMap but returns an error instead of panicking
+func (g *Graph[T, N]) TryAllDijkstraShortestPathsMap(source, dest T) (map[T][]T, N, error) {
+	return g.shortestPathMap(source, dest, true, nil, nil)
 }
 
 func (g *Graph[T, N]) AllShortestPathsNodes(source, dest T) ([]T, N) {
+	return must2(g.TryAllShortestPathsNodes(source, dest))
+}
+
+// Same as AllShortestPathsNodes but returns an error instead of panicking
+func (g *Graph[T, N]) TryAllShortestPathsNodes(source, dest T) ([]T, N, error) {
 	// Returns all nodes which are part of the shortest path
 
-	prev, dist := g.shortestPathMap(source, dest, true, nil)
+	prev, dist, err := g.shortestPathMap(source, dest, true, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
 	if prev == nil {
 		// No path was found
-		return nil, 0
+		return nil, 0, nil
 	}
 
 	visited := make(map[T]bool, g.NumberOfNodes())
@@ -248,12 +304,11 @@ func (g *Graph[T, N]) AllShortestPathsNodes(source, dest T) ([]T, N) {
 		res[i] = n
 		i++
 	}
-	return res, dist
+	return res, dist, nil
 }
 
 func (g *Graph[T, N]) DijkstraShortestPathWithoutNodes(source, dest T) ([]T, N) {
-	prev, dist := g.shortestPathMap(source, dest, false, nil)
-	return pathFromShortestPathMap(dest, prev, dist)
+	return g.DijkstraShortestPath(source, dest)
 }
 
 type DijkstraDisjointShortestPathIterator[T comparable, N Number] struct {
@@ -263,17 +318,28 @@ type DijkstraDisjointShortestPathIterator[T comparable, N Number] struct {
 	path []T
 }
 
+// Returns an iterator over equal-cost shortest paths from source to dest.
+// Despite the name, the returned paths are not guaranteed to be disjoint. See
+// EdgeDisjointShortestPaths and NodeDisjointShortestPaths for disjoint paths.
 func (g *Graph[T, N]) AllDijkstraDisjointShortestPaths(source, dest T) *DijkstraDisjointShortestPathIterator[T, N] {
-	prev, dist := g.shortestPathMap(source, dest, true, nil)
+	return must(g.TryAllDijkstraDisjointShortestPaths(source, dest))
+}
+
+// Same as AllDijkstraDisjointShortestPaths but returns an error instead of panicking
+func (g *Graph[T, N]) TryAllDijkstraDisjointShortestPaths(source, dest T) (*DijkstraDisjointShortestPathIterator[T, N], error) {
+	prev, dist, err := g.shortestPathMap(source, dest, true, nil, nil)
+	if err != nil {
+		return nil, err
+	}
 	if prev == nil {
-		return &DijkstraDisjointShortestPathIterator[T, N]{}
+		return &DijkstraDisjointShortestPathIterator[T, N]{}, nil
 	}
 
 	return &DijkstraDisjointShortestPathIterator[T, N]{
 		dest: dest,
 		prev: prev,
 		dist: dist,
-	}
+	}, nil
 }
 
 func (it *DijkstraDisjointShortestPathIterator[T, N]) Shuffle() *DijkstraDisjointShortestPathIterator[T, N] {
@@ -1,6 +1,7 @@
 package edsger
 
 import (
+	"errors"
 	"slices"
 	"testing"
 )
@@ -144,3 +145,19 @@ func BenchmarkDijkstraShortestPath(b *testing.B) {
 		}
 	}
 }
+
+func TestTryDijkstraShortestPath(t *testing.T) {
+	g := WikipediaGraph()
+	if _, _, err := g.TryDijkstraShortestPath(1, 42); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	g.UpdateEdge(3, 6, -2)
+	if _, _, err := g.TryDijkstraShortestPath(1, 5); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	if path, total, err := WikipediaGraph().TryDijkstraShortestPath(1, 5); err != nil || total != 20 {
+		t.Fatal("Invalid path:", path, err)
+	}
+}
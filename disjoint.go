@@ -0,0 +1,124 @@
+package edsger
+
+import "fmt"
+
+// Computes k edge-disjoint paths from source to dest with minimal total cost
+// using Suurballe's algorithm, as generalized by Bhandari to k paths. Fewer
+// than k paths are returned if the graph does not contain k edge-disjoint
+// paths. Edge weights must be non-negative.
+func (g *Graph[T, N]) EdgeDisjointShortestPaths(source, dest T, k int) ([][]T, N, error) {
+	return g.disjointShortestPaths(source, dest, k, false)
+}
+
+// Computes k node-disjoint paths from source to dest with minimal total cost
+// using Suurballe's algorithm, as generalized by Bhandari to k paths. Fewer
+// than k paths are returned if the graph does not contain k node-disjoint
+// paths. Edge weights must be non-negative.
+func (g *Graph[T, N]) NodeDisjointShortestPaths(source, dest T, k int) ([][]T, N, error) {
+	return g.disjointShortestPaths(source, dest, k, true)
+}
+
+func (g *Graph[T, N]) disjointShortestPaths(source, dest T, k int, nodeDisjoint bool) ([][]T, N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, 0, err
+	}
+	if source == dest || k <= 0 {
+		return nil, 0, nil
+	}
+
+	// Each path carries one unit of flow. For node-disjoint paths, each node
+	// is split into an input and an output node connected by an arc of unit
+	// capacity.
+	nodes := g.NodesList()
+	id := func(n T) (int, int) {
+		i := g.nodes[n]
+		if nodeDisjoint {
+			return 2 * i, 2*i + 1
+		}
+		return i, i
+	}
+
+	size := len(nodes)
+	if nodeDisjoint {
+		size *= 2
+	}
+	fn := newFlowNetwork[N](size)
+	if nodeDisjoint {
+		for _, n := range nodes {
+			in, out := id(n)
+			if n == source || n == dest {
+				fn.addArc(in, out, N(k), 0)
+			} else {
+				fn.addArc(in, out, 1, 0)
+			}
+		}
+	}
+
+	type arcPair struct {
+		forward, backward *flowArc[N]
+	}
+	var pairs []arcPair
+	for src, edges := range g.edges {
+		uin, uout := id(src)
+		for _, e := range edges {
+			if e.Weight < 0 {
+				return nil, 0, fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, src, e.Node)
+			}
+			if !g.directed && g.nodes[src] > g.nodes[e.Node] {
+				continue
+			}
+
+			vin, vout := id(e.Node)
+			a := fn.addArc(uout, vin, 1, e.Weight)
+			if !g.directed {
+				b := fn.addArc(vout, uin, 1, e.Weight)
+				if !nodeDisjoint {
+					pairs = append(pairs, arcPair{a, b})
+				}
+			}
+		}
+	}
+
+	s, _ := id(source)
+	_, t := id(dest)
+	flow, err := fn.successiveShortestPaths(s, t, N(k))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// An undirected edge traversed in both directions by two paths is not
+	// used by any of them
+	for _, p := range pairs {
+		if p.forward.flow() > 0 && p.backward.flow() > 0 {
+			p.forward.residual++
+			p.forward.rev.residual--
+			p.backward.residual++
+			p.backward.rev.residual--
+		}
+	}
+
+	var total N
+	paths := make([][]T, 0, int(flow))
+	for range int(flow) {
+		ids := fn.extractPath(s, t)
+		if ids == nil {
+			break
+		}
+
+		path := make([]T, 0, len(ids))
+		for _, i := range ids {
+			if nodeDisjoint {
+				i /= 2
+			}
+			if n := nodes[i]; len(path) == 0 || path[len(path)-1] != n {
+				path = append(path, n)
+			}
+		}
+		for i := range len(path) - 1 {
+			w, _ := g.getEdge(path[i], path[i+1])
+			total += w
+		}
+		paths = append(paths, path)
+	}
+	return paths, total, nil
+}
@@ -0,0 +1,156 @@
+package edsger
+
+import (
+	"testing"
+)
+
+// Classic trap topology where the two disjoint paths cannot include the
+// shortest path s-a-b-t
+func TrapGraph(directed bool) *Graph[string, int] {
+	var g *Graph[string, int]
+	if directed {
+		g = NewDirectedGraph[string, int]()
+	} else {
+		g = NewUndirectedGraph[string, int]()
+	}
+	for _, n := range []string{"s", "a", "b", "c", "d", "t"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("s", "a", 1)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "t", 1)
+	g.AddEdge("s", "c", 2)
+	g.AddEdge("c", "b", 2)
+	g.AddEdge("a", "d", 2)
+	g.AddEdge("d", "t", 2)
+	return g
+}
+
+func validateDisjointPaths[T comparable, N Number](t *testing.T, g *Graph[T, N], paths [][]T, total N, nodeDisjoint bool) {
+	source, dest := paths[0][0], paths[0][len(paths[0])-1]
+	edges := make(map[WeightedEdge[T, N]]bool)
+	nodes := make(map[T]bool)
+
+	var sum N
+	for _, path := range paths {
+		if path[0] != source || path[len(path)-1] != dest {
+			t.Fatal("Invalid path:", path)
+		}
+		for i := range len(path) - 1 {
+			w, ok := g.GetEdge(path[i], path[i+1])
+			if !ok {
+				t.Fatal("Invalid path:", path)
+			}
+			sum += w
+
+			e := WeightedEdge[T, N]{From: path[i], To: path[i+1]}
+			if !g.IsDirected() && g.nodes[e.From] > g.nodes[e.To] {
+				e.From, e.To = e.To, e.From
+			}
+			if edges[e] {
+				t.Fatal("Paths are not edge-disjoint:", paths)
+			}
+			edges[e] = true
+		}
+		if nodeDisjoint {
+			for _, n := range path[1 : len(path)-1] {
+				if nodes[n] {
+					t.Fatal("Paths are not node-disjoint:", paths)
+				}
+				nodes[n] = true
+			}
+		}
+	}
+	if sum != total {
+		t.Fatal("Invalid total cost:", sum, total)
+	}
+}
+
+func TestDisjointShortestPathsTrap(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		g := TrapGraph(directed)
+		for _, nodeDisjoint := range []bool{false, true} {
+			var paths [][]string
+			var total int
+			var err error
+			if nodeDisjoint {
+				paths, total, err = g.NodeDisjointShortestPaths("s", "t", 2)
+			} else {
+				paths, total, err = g.EdgeDisjointShortestPaths("s", "t", 2)
+			}
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			t.Log(paths, total)
+			if len(paths) != 2 || total != 10 {
+				t.Fatal("Invalid paths:", paths, total)
+			}
+			validateDisjointPaths(t, g, paths, total, nodeDisjoint)
+		}
+	}
+}
+
+func TestDisjointShortestPathsBowtie(t *testing.T) {
+	// Two triangles sharing node 2: two edge-disjoint paths exist but only one
+	// node-disjoint path
+	g := NewUndirectedGraph[int, int]()
+	for i := range 5 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(3, 4, 1)
+
+	paths, total, err := g.EdgeDisjointShortestPaths(0, 4, 3)
+	if err != nil || len(paths) != 2 || total != 6 {
+		t.Fatal("Invalid paths:", paths, total, err)
+	}
+	validateDisjointPaths(t, g, paths, total, false)
+
+	paths, total, err = g.NodeDisjointShortestPaths(0, 4, 3)
+	if err != nil || len(paths) != 1 || total != 2 {
+		t.Fatal("Invalid paths:", paths, total, err)
+	}
+}
+
+func TestDisjointShortestPathsRandomGraphs(t *testing.T) {
+	for seed := range int64(5) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 30, 150, 1, 10, seed)
+			_, shortest := g.DijkstraShortestPath(0, 1)
+
+			for _, nodeDisjoint := range []bool{false, true} {
+				var paths [][]int
+				var total int
+				var err error
+				if nodeDisjoint {
+					paths, total, err = g.NodeDisjointShortestPaths(0, 1, 3)
+				} else {
+					paths, total, err = g.EdgeDisjointShortestPaths(0, 1, 3)
+				}
+				if err != nil {
+					t.Fatal("Unexpected error:", err)
+				}
+				if len(paths) == 0 {
+					continue
+				}
+				validateDisjointPaths(t, g, paths, total, nodeDisjoint)
+
+				if len(paths) == 1 && total != shortest {
+					t.Fatal("Invalid single path:", paths, total, shortest)
+				}
+			}
+		}
+	}
+}
+
+func TestDisjointShortestPathsNoPath(t *testing.T) {
+	g := NoPathGraph()
+	paths, _, err := g.EdgeDisjointShortestPaths(1, 5, 2)
+	if err != nil || len(paths) != 0 {
+		t.Fatal("Invalid paths:", paths, err)
+	}
+}
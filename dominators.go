@@ -0,0 +1,154 @@
+package edsger
+
+import "slices"
+
+// Dominator tree of a flow graph. A node d dominates a node n if every path
+// from the entry to n goes through d. Only nodes reachable from the entry
+// are part of the tree.
+type DominatorTree[T comparable, N Number] struct {
+	Entry T
+	// Immediate dominator of each node except the entry
+	Idom map[T]T
+	// Tree with an edge from each immediate dominator to the nodes it
+	// immediately dominates. All edges have a zero weight.
+	Tree *Graph[T, N]
+	// Dominance frontier of each node
+	Frontiers map[T][]T
+
+	order map[T]int
+}
+
+// Returns true if a dominates b. Every node dominates itself.
+func (dt *DominatorTree[T, N]) Dominates(a, b T) bool {
+	if _, ok := dt.order[b]; !ok {
+		return false
+	}
+	for {
+		if a == b {
+			return true
+		}
+		if b == dt.Entry {
+			return false
+		}
+		b = dt.Idom[b]
+	}
+}
+
+// Computes the dominator tree and dominance frontiers of a flow graph using
+// the algorithm of Cooper, Harvey and Kennedy
+func (g *Graph[T, N]) Dominators(entry T) (*DominatorTree[T, N], error) {
+	if err := g.checkNode(entry); err != nil {
+		return nil, err
+	}
+	return dominators(entry, g.edges, g.reverseEdges()), nil
+}
+
+// Computes the post-dominator tree and post-dominance frontiers of a flow
+// graph, i.e. the dominators of the reverse graph starting from exit
+func (g *Graph[T, N]) PostDominators(exit T) (*DominatorTree[T, N], error) {
+	if err := g.checkNode(exit); err != nil {
+		return nil, err
+	}
+	return dominators(exit, g.reverseEdges(), g.edges), nil
+}
+
+func dominators[T comparable, N Number](entry T, successors, predecessors map[T][]*NodeWeight[T, N]) *DominatorTree[T, N] {
+	// Compute the reverse postorder using an iterative DFS
+	type frame struct {
+		node T
+		edge int
+	}
+	visited := map[T]bool{entry: true}
+	var postorder []T
+	stack := []frame{{node: entry}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.edge < len(successors[top.node]) {
+			v := successors[top.node][top.edge].Node
+			top.edge++
+			if !visited[v] {
+				visited[v] = true
+				stack = append(stack, frame{node: v})
+			}
+			continue
+		}
+		postorder = append(postorder, top.node)
+		stack = stack[:len(stack)-1]
+	}
+
+	// Position of each node in postorder
+	order := make(map[T]int, len(postorder))
+	for i, n := range postorder {
+		order[n] = i
+	}
+
+	idom := map[T]T{entry: entry}
+	intersect := func(a, b T) T {
+		for a != b {
+			for order[a] < order[b] {
+				a = idom[a]
+			}
+			for order[b] < order[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for i := len(postorder) - 2; i >= 0; i-- {
+			b := postorder[i]
+			var newIdom T
+			found := false
+			for _, p := range predecessors[b] {
+				if _, ok := idom[p.Node]; !ok {
+					continue
+				}
+				if !found {
+					newIdom, found = p.Node, true
+				} else {
+					newIdom = intersect(p.Node, newIdom)
+				}
+			}
+			if old, ok := idom[b]; !ok || old != newIdom {
+				idom[b] = newIdom
+				changed = true
+			}
+		}
+	}
+	delete(idom, entry)
+
+	dt := &DominatorTree[T, N]{
+		Entry:     entry,
+		Idom:      idom,
+		Tree:      NewDirectedGraph[T, N](),
+		Frontiers: make(map[T][]T, len(postorder)),
+		order:     order,
+	}
+	for _, n := range postorder {
+		dt.Tree.AddNode(n)
+	}
+	for n, d := range idom {
+		dt.Tree.addEdge(d, n, 0)
+	}
+
+	for _, b := range postorder {
+		for _, p := range predecessors[b] {
+			if !visited[p.Node] {
+				continue
+			}
+			// Walk up the dominator tree from each predecessor until the
+			// immediate dominator of b. The entry has no immediate dominator.
+			for runner := p.Node; b == entry || runner != idom[b]; runner = idom[runner] {
+				if !slices.Contains(dt.Frontiers[runner], b) {
+					dt.Frontiers[runner] = append(dt.Frontiers[runner], b)
+				}
+				if runner == entry {
+					break
+				}
+			}
+		}
+	}
+	return dt
+}
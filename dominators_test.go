@@ -0,0 +1,100 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func ControlFlowGraph() *Graph[int, int] {
+	// Source: https://en.wikipedia.org/wiki/Dominator_(graph_theory)
+	g := NewDirectedGraph[int, int]()
+	for i := range 6 {
+		g.AddNode(i + 1)
+	}
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(2, 6, 1)
+	g.AddEdge(3, 5, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 2, 1)
+	return g
+}
+
+func TestDominators(t *testing.T) {
+	g := ControlFlowGraph()
+	dt, err := g.Dominators(1)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	expected := map[int]int{2: 1, 3: 2, 4: 2, 5: 2, 6: 2}
+	for n, d := range expected {
+		if dt.Idom[n] != d {
+			t.Fatal("Invalid immediate dominator of", n, dt.Idom[n])
+		}
+	}
+	if len(dt.Idom) != len(expected) {
+		t.Fatal("Invalid immediate dominators:", dt.Idom)
+	}
+	if dt.Tree.NumberOfEdges() != 5 || !dt.Tree.HasEdge(2, 5) {
+		t.Fatal("Invalid dominator tree")
+	}
+	if !dt.Dominates(1, 5) || !dt.Dominates(2, 5) || dt.Dominates(3, 5) || !dt.Dominates(5, 5) {
+		t.Fatal("Invalid dominance")
+	}
+
+	frontiers := map[int][]int{1: nil, 2: {2}, 3: {5}, 4: {5}, 5: {2}, 6: nil}
+	for n, f := range frontiers {
+		df := dt.Frontiers[n]
+		slices.Sort(df)
+		if !slices.Equal(df, f) {
+			t.Fatal("Invalid dominance frontier of", n, df)
+		}
+	}
+
+	if _, err := g.Dominators(42); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestDominatorsBruteForce(t *testing.T) {
+	// d dominates n iff n is not reachable from the entry when d is removed
+	for seed := range int64(5) {
+		g := RandomGraph(true, 20, 40, 1, 1, seed)
+		dt, _ := g.Dominators(0)
+		for d := range dt.Tree.Nodes() {
+			excluded := map[int]bool{d: true}
+			for n := range dt.Tree.Nodes() {
+				if n == d || d == 0 {
+					continue
+				}
+				path, _ := g.DijkstraShortestPathWithExclusionMap(0, n, excluded)
+				if (path == nil) != dt.Dominates(d, n) {
+					t.Fatal("Invalid dominance between", d, n)
+				}
+			}
+		}
+	}
+}
+
+func TestPostDominators(t *testing.T) {
+	g := ControlFlowGraph()
+	g.AddNode(7)
+	g.AddEdge(6, 7, 1)
+	g.AddEdge(3, 7, 1)
+
+	dt, err := g.PostDominators(7)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	t.Log(dt.Idom)
+
+	expected := map[int]int{1: 2, 2: 7, 3: 7, 4: 5, 5: 2, 6: 7}
+	for n, d := range expected {
+		if dt.Idom[n] != d {
+			t.Fatal("Invalid immediate post-dominator of", n, dt.Idom[n])
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package edsger
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Computes the eccentricity of each node, i.e. the maximum shortest path
+// distance from the node to any other node. Returns ErrDisconnected if some
+// node cannot reach all other nodes (for directed graphs, if the graph is not
+// strongly connected). Edge weights are used as distances and must be
+// non-negative.
+func (g *Graph[T, N]) Eccentricity() (map[T]N, error) {
+	n := len(g.nodes)
+	res := make(map[T]N, n)
+	var mu sync.Mutex
+	var unreachable []T
+	err := g.eachSourceDistances(func(source T, dist map[T]N) {
+		var ecc N
+		for _, d := range dist {
+			ecc = max(ecc, d)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(dist) < n {
+			unreachable = append(unreachable, source)
+		}
+		res[source] = ecc
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(unreachable) > 0 {
+		return nil, fmt.Errorf("%w: not all nodes are reachable from %v", ErrDisconnected, unreachable[0])
+	}
+	return res, nil
+}
+
+// Returns the diameter of the graph, i.e. the maximum eccentricity
+func (g *Graph[T, N]) Diameter() (N, error) {
+	ecc, err := g.Eccentricity()
+	if err != nil {
+		return 0, err
+	}
+	var diameter N
+	for _, e := range ecc {
+		diameter = max(diameter, e)
+	}
+	return diameter, nil
+}
+
+// Returns the radius of the graph, i.e. the minimum eccentricity
+func (g *Graph[T, N]) Radius() (N, error) {
+	ecc, err := g.Eccentricity()
+	if err != nil {
+		return 0, err
+	}
+	radius := MaxValue[N]()
+	if len(ecc) == 0 {
+		radius = 0
+	}
+	for _, e := range ecc {
+		radius = min(radius, e)
+	}
+	return radius, nil
+}
+
+// Returns the center of the graph, i.e. the nodes whose eccentricity is equal
+// to the radius, in the order of NodesList
+func (g *Graph[T, N]) Center() ([]T, error) {
+	ecc, err := g.Eccentricity()
+	if err != nil {
+		return nil, err
+	}
+	radius := MaxValue[N]()
+	for _, e := range ecc {
+		radius = min(radius, e)
+	}
+	return g.nodesWithEccentricity(ecc, radius), nil
+}
+
+// Returns the periphery of the graph, i.e. the nodes whose eccentricity is
+// equal to the diameter, in the order of NodesList
+func (g *Graph[T, N]) Periphery() ([]T, error) {
+	ecc, err := g.Eccentricity()
+	if err != nil {
+		return nil, err
+	}
+	var diameter N
+	for _, e := range ecc {
+		diameter = max(diameter, e)
+	}
+	return g.nodesWithEccentricity(ecc, diameter), nil
+}
+
+func (g *Graph[T, N]) nodesWithEccentricity(ecc map[T]N, value N) []T {
+	var res []T
+	for _, node := range g.NodesList() {
+		if ecc[node] == value {
+			res = append(res, node)
+		}
+	}
+	return res
+}
@@ -0,0 +1,80 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestEccentricity(t *testing.T) {
+	g := PathGraph(5)
+
+	ecc, err := g.Eccentricity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for node, e := range map[int]int{0: 4, 1: 3, 2: 2, 3: 3, 4: 4} {
+		if ecc[node] != e {
+			t.Fatalf("Invalid eccentricity for %d: expected %d, got %d", node, e, ecc[node])
+		}
+	}
+
+	if d, _ := g.Diameter(); d != 4 {
+		t.Fatalf("Invalid diameter: %d", d)
+	}
+	if r, _ := g.Radius(); r != 2 {
+		t.Fatalf("Invalid radius: %d", r)
+	}
+	if c, _ := g.Center(); !slices.Equal(c, []int{2}) {
+		t.Fatalf("Invalid center: %v", c)
+	}
+	if p, _ := g.Periphery(); !slices.Equal(p, []int{0, 4}) {
+		t.Fatalf("Invalid periphery: %v", p)
+	}
+}
+
+func TestEccentricityKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	// Reference values from networkx
+	if d, _ := g.Diameter(); d != 5 {
+		t.Fatalf("Invalid diameter: %d", d)
+	}
+	if r, _ := g.Radius(); r != 3 {
+		t.Fatalf("Invalid radius: %d", r)
+	}
+	if c, _ := g.Center(); !slices.Equal(slices.Sorted(slices.Values(c)), []int{0, 1, 2, 3, 8, 13, 19, 31}) {
+		t.Fatalf("Invalid center: %v", c)
+	}
+}
+
+func TestEccentricityWeighted(t *testing.T) {
+	g := WikipediaGraph()
+
+	ecc, err := g.Eccentricity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _ := g.Diameter()
+	for src := range g.Nodes() {
+		for dst := range g.Nodes() {
+			if _, cost := g.DijkstraShortestPath(src, dst); cost > ecc[src] || cost > d {
+				t.Fatalf("Distance from %d to %d exceeds eccentricity", src, dst)
+			}
+		}
+	}
+}
+
+func TestEccentricityDisconnected(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, 1)
+
+	if _, err := g.Eccentricity(); !errors.Is(err, ErrDisconnected) {
+		t.Fatalf("Expected ErrDisconnected, got %v", err)
+	}
+	if _, err := g.Diameter(); !errors.Is(err, ErrDisconnected) {
+		t.Fatalf("Expected ErrDisconnected, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package edsger
+
+import (
+	"fmt"
+	"math"
+)
+
+// Options of the Katz centrality computation
+type KatzOptions struct {
+	PowerIterationOptions
+	// Attenuation factor of the contribution of longer walks. Must be smaller
+	// than the inverse of the largest eigenvalue of the adjacency matrix for
+	// the computation to converge. Uses 0.1 if not set.
+	Alpha float64
+	// Centrality given to each node independently of its neighbors. Uses 1 if
+	// not set.
+	Beta float64
+}
+
+// Computes the eigenvector centrality of each node using power iteration.
+// The centrality of a node is proportional to the sum of the centralities of
+// the nodes with an edge to it. Scores are normalized to unit Euclidean
+// norm. Returns ErrNotConverged if the scores do not converge within the
+// maximum number of iterations. The default tolerance is 1e-6.
+func (g *Graph[T, N]) EigenvectorCentrality(opts PowerIterationOptions) (map[T]float64, error) {
+	n := len(g.nodes)
+	if n == 0 {
+		return map[T]float64{}, nil
+	}
+	opts = opts.withDefaults(1e-6)
+
+	adj, err := g.floatAdjacency(opts.Weighted)
+	if err != nil {
+		return nil, err
+	}
+	in := transpose(adj)
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	for range opts.MaxIterations {
+		// Iterate using A + I instead of A to ensure convergence on
+		// bipartite graphs, which does not change the eigenvectors
+		next := make([]float64, n)
+		parallelRange(n, opts.Workers, func(lo, hi int) {
+			for v := lo; v < hi; v++ {
+				next[v] = x[v]
+				for _, e := range in[v] {
+					next[v] += x[e.to] * e.weight
+				}
+			}
+		})
+		scaleToNorm(next)
+
+		diff := l1Distance(next, x)
+		x = next
+		if diff < float64(n)*opts.Tolerance {
+			return g.indexedScores(x), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: eigenvector centrality after %d iterations", ErrNotConverged, opts.MaxIterations)
+}
+
+// Computes the Katz centrality of each node using power iteration. The
+// centrality of a node is the sum of the walks ending at the node, where
+// walks of length $k$ are attenuated by $\alpha^k$, plus $\beta$. Scores are
+// normalized to unit Euclidean norm. Returns ErrNotConverged if the scores do
+// not converge within the maximum number of iterations. The default
+// tolerance is 1e-6.
+func (g *Graph[T, N]) KatzCentrality(opts KatzOptions) (map[T]float64, error) {
+	n := len(g.nodes)
+	if n == 0 {
+		return map[T]float64{}, nil
+	}
+	alpha, beta := opts.Alpha, opts.Beta
+	if alpha == 0 {
+		alpha = 0.1
+	}
+	if beta == 0 {
+		beta = 1
+	}
+	po := opts.PowerIterationOptions.withDefaults(1e-6)
+
+	adj, err := g.floatAdjacency(po.Weighted)
+	if err != nil {
+		return nil, err
+	}
+	in := transpose(adj)
+
+	x := make([]float64, n)
+	for range po.MaxIterations {
+		next := make([]float64, n)
+		parallelRange(n, po.Workers, func(lo, hi int) {
+			for v := lo; v < hi; v++ {
+				var s float64
+				for _, e := range in[v] {
+					s += x[e.to] * e.weight
+				}
+				next[v] = alpha*s + beta
+			}
+		})
+
+		diff := l1Distance(next, x)
+		x = next
+		if diff < float64(n)*po.Tolerance {
+			scaleToNorm(x)
+			return g.indexedScores(x), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: Katz centrality after %d iterations", ErrNotConverged, po.MaxIterations)
+}
+
+// Scales the values such that their Euclidean norm is one
+func scaleToNorm(x []float64) {
+	var s float64
+	for _, v := range x {
+		s += v * v
+	}
+	if s > 0 {
+		norm := math.Sqrt(s)
+		for i := range x {
+			x[i] /= norm
+		}
+	}
+}
+
+// Returns the sum of the absolute differences between the values
+func l1Distance(x, y []float64) float64 {
+	var diff float64
+	for i := range x {
+		diff += math.Abs(x[i] - y[i])
+	}
+	return diff
+}
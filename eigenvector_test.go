@@ -0,0 +1,84 @@
+package edsger
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEigenvectorCentrality(t *testing.T) {
+	g := PathGraph(3)
+
+	ec, err := g.EigenvectorCentrality(PowerIterationOptions{Tolerance: 1e-12, MaxIterations: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, ec, map[int]float64{0: 0.5, 1: math.Sqrt2 / 2, 2: 0.5})
+}
+
+func TestEigenvectorCentralityKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	ec, err := g.EigenvectorCentrality(PowerIterationOptions{Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Reference values from networkx.eigenvector_centrality
+	for node, score := range map[int]float64{0: 0.3554834941851943, 33: 0.373371213013235} {
+		if math.Abs(ec[node]-score) > 1e-4 {
+			t.Fatalf("Invalid score for %d: expected %v, got %v", node, score, ec[node])
+		}
+	}
+}
+
+func TestEigenvectorCentralityNotConverged(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	if _, err := g.EigenvectorCentrality(PowerIterationOptions{MaxIterations: 2}); !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("Expected ErrNotConverged, got %v", err)
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	g := PathGraph(3)
+
+	kc, err := g.KatzCentrality(KatzOptions{PowerIterationOptions: PowerIterationOptions{Tolerance: 1e-12}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Solution of x = 0.1 A x + 1
+	x0, x1 := 1.1/0.98, 0.2*1.1/0.98+1
+	norm := math.Sqrt(2*x0*x0 + x1*x1)
+	assertScores(t, kc, map[int]float64{0: x0 / norm, 1: x1 / norm, 2: x0 / norm})
+}
+
+func TestKatzCentralityWeighted(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 3 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 5)
+
+	kc, err := g.KatzCentrality(KatzOptions{PowerIterationOptions: PowerIterationOptions{Weighted: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Solution of x = 0.1 A^T x + 1
+	norm := math.Sqrt(1 + 1.1*1.1 + 1.5*1.5)
+	assertScores(t, kc, map[int]float64{0: 1 / norm, 1: 1.1 / norm, 2: 1.5 / norm})
+
+	g.UpdateEdge(0, 2, -1)
+	if _, err := g.KatzCentrality(KatzOptions{PowerIterationOptions: PowerIterationOptions{Weighted: true}}); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestKatzCentralityNotConverged(t *testing.T) {
+	// Alpha is larger than the inverse of the largest eigenvalue
+	g := UnweightedKarateClubGraph()
+
+	if _, err := g.KatzCentrality(KatzOptions{Alpha: 0.5}); !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("Expected ErrNotConverged, got %v", err)
+	}
+}
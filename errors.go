@@ -0,0 +1,26 @@
+package edsger
+
+import "errors"
+
+// Sentinel errors returned by the error-returning variants of the graph
+// operations. They are wrapped with additional context and should be tested
+// using errors.Is.
+var (
+	ErrNodeExists         = errors.New("node already in graph")
+	ErrNodeNotFound       = errors.New("node not found")
+	ErrEdgeExists         = errors.New("edge already defined")
+	ErrEdgeNotFound       = errors.New("edge not found")
+	ErrNegativeWeight     = errors.New("negative edge weight")
+	ErrNotDirected        = errors.New("graph is not directed")
+	ErrDirected           = errors.New("graph is directed")
+	ErrCycle              = errors.New("graph contains at least one cycle")
+	ErrNegativeCycle      = errors.New("graph contains a negative cycle")
+	ErrSameSourceSink     = errors.New("source and sink are the same node")
+	ErrUnbalancedSupplies = errors.New("supplies are not balanced")
+	ErrInfeasibleFlow     = errors.New("flow is infeasible")
+	ErrDisconnected       = errors.New("graph is not connected")
+	ErrNotConverged       = errors.New("power iteration did not converge")
+	ErrInvalidWeights     = errors.New("invalid distribution of node weights")
+	ErrInvalidPartition   = errors.New("invalid partition of the nodes")
+	ErrInexactCost        = errors.New("costs cannot be represented exactly")
+)
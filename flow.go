@@ -0,0 +1,276 @@
+package edsger
+
+import "fmt"
+
+// Algorithm used to compute maximum flows
+type MaxFlowAlgorithm int
+
+const (
+	// Dinic's blocking flow algorithm
+	Dinic MaxFlowAlgorithm = iota
+	// FIFO push-relabel algorithm of Goldberg and Tarjan
+	PushRelabel
+)
+
+// Edge of the graph with the corresponding arcs in the flow network
+type flowEdge[T comparable, N Number] struct {
+	from, to          T
+	forward, backward *flowArc[N]
+}
+
+// Builds a flow network where edge weights are capacities. Undirected edges
+// are represented by one arc in each direction.
+func (g *Graph[T, N]) capacityNetwork() (*flowNetwork[N], []*flowEdge[T, N], error) {
+	fn := newFlowNetwork[N](len(g.nodes))
+	edges := make([]*flowEdge[T, N], 0, g.NumberOfEdges())
+	for e := range g.Edges() {
+		if e.Weight < 0 {
+			return nil, nil, fmt.Errorf("%w: (%v, %v)", ErrNegativeWeight, e.From, e.To)
+		}
+		u, v := g.nodes[e.From], g.nodes[e.To]
+		fe := &flowEdge[T, N]{
+			from:    e.From,
+			to:      e.To,
+			forward: fn.addArc(u, v, e.Weight, 0),
+		}
+		if !g.directed {
+			fe.backward = fn.addArc(v, u, e.Weight, 0)
+		}
+		edges = append(edges, fe)
+	}
+	return fn, edges, nil
+}
+
+func (g *Graph[T, N]) maxFlow(source, sink T, algorithm MaxFlowAlgorithm) (N, *flowNetwork[N], []*flowEdge[T, N], error) {
+	if err := g.checkPathNodes(source, sink); err != nil {
+		return 0, nil, nil, err
+	}
+	if source == sink {
+		return 0, nil, nil, ErrSameSourceSink
+	}
+
+	fn, edges, err := g.capacityNetwork()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	s, t := g.nodes[source], g.nodes[sink]
+	switch algorithm {
+	case Dinic:
+		return fn.dinic(s, t), fn, edges, nil
+	case PushRelabel:
+		return fn.pushRelabel(s, t), fn, edges, nil
+	}
+	return 0, nil, nil, fmt.Errorf("unknown max flow algorithm: %d", algorithm)
+}
+
+// Computes the maximum flow from source to sink using Dinic's algorithm,
+// where edge weights are capacities. Returns the flow value and the flow on
+// each edge carrying a positive flow. For undirected graphs, the returned
+// edges are oriented in the direction of the flow.
+func (g *Graph[T, N]) MaxFlow(source, sink T) (N, []*WeightedEdge[T, N], error) {
+	return g.MaxFlowWithAlgorithm(source, sink, Dinic)
+}
+
+// Same as MaxFlow using the given algorithm
+func (g *Graph[T, N]) MaxFlowWithAlgorithm(source, sink T, algorithm MaxFlowAlgorithm) (N, []*WeightedEdge[T, N], error) {
+	value, _, edges, err := g.maxFlow(source, sink, algorithm)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var flows []*WeightedEdge[T, N]
+	for _, e := range edges {
+		from, to, f := e.from, e.to, e.forward.flow()
+		if e.backward != nil {
+			// Net flow over the undirected edge
+			if b := e.backward.flow(); b > f {
+				from, to, f = to, from, b-f
+			} else {
+				f -= b
+			}
+		}
+		if f > 0 {
+			flows = append(flows, &WeightedEdge[T, N]{From: from, To: to, Weight: f})
+		}
+	}
+	return value, flows, nil
+}
+
+// Computes a minimum s-t cut using Dinic's algorithm, where edge weights are
+// capacities. Returns the cut value, the edges crossing the cut and the set of
+// nodes on the source side of the cut.
+func (g *Graph[T, N]) MinCut(source, sink T) (N, []*WeightedEdge[T, N], map[T]bool, error) {
+	return g.MinCutWithAlgorithm(source, sink, Dinic)
+}
+
+// Same as MinCut using the given algorithm
+func (g *Graph[T, N]) MinCutWithAlgorithm(source, sink T, algorithm MaxFlowAlgorithm) (N, []*WeightedEdge[T, N], map[T]bool, error) {
+	value, fn, edges, err := g.maxFlow(source, sink, algorithm)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	// Nodes reachable from the source in the residual network
+	nodes := g.NodesList()
+	reachable := fn.reachable(g.nodes[source])
+	sourceSide := make(map[T]bool)
+	for i, ok := range reachable {
+		if ok {
+			sourceSide[nodes[i]] = true
+		}
+	}
+
+	var cut []*WeightedEdge[T, N]
+	for _, e := range edges {
+		if sourceSide[e.from] && !sourceSide[e.to] {
+			cut = append(cut, &WeightedEdge[T, N]{From: e.from, To: e.to, Weight: e.forward.capacity})
+		} else if e.backward != nil && sourceSide[e.to] && !sourceSide[e.from] {
+			cut = append(cut, &WeightedEdge[T, N]{From: e.to, To: e.from, Weight: e.backward.capacity})
+		}
+	}
+	return value, cut, sourceSide, nil
+}
+
+// Returns the nodes reachable from s in the residual network
+func (fn *flowNetwork[N]) reachable(s int) []bool {
+	visited := make([]bool, len(fn.arcs))
+	visited[s] = true
+	q := []int{s}
+	for len(q) > 0 {
+		u := q[0]
+		q = q[1:]
+		for _, a := range fn.arcs[u] {
+			if a.residual > 0 && !visited[a.to] {
+				visited[a.to] = true
+				q = append(q, a.to)
+			}
+		}
+	}
+	return visited
+}
+
+// Implementation of Dinic's algorithm
+func (fn *flowNetwork[N]) dinic(s, t int) N {
+	n := len(fn.arcs)
+	level := make([]int, n)
+	next := make([]int, n)
+
+	// Computes the level graph using a BFS
+	bfs := func() bool {
+		for i := range level {
+			level[i] = -1
+		}
+		level[s] = 0
+		q := []int{s}
+		for len(q) > 0 {
+			u := q[0]
+			q = q[1:]
+			for _, a := range fn.arcs[u] {
+				if a.residual > 0 && level[a.to] < 0 {
+					level[a.to] = level[u] + 1
+					q = append(q, a.to)
+				}
+			}
+		}
+		return level[t] >= 0
+	}
+
+	// Finds an augmenting path in the level graph
+	var dfs func(u int, f N) N
+	dfs = func(u int, f N) N {
+		if u == t {
+			return f
+		}
+		for ; next[u] < len(fn.arcs[u]); next[u]++ {
+			a := fn.arcs[u][next[u]]
+			if a.residual <= 0 || level[a.to] != level[u]+1 {
+				continue
+			}
+			if d := dfs(a.to, min(f, a.residual)); d > 0 {
+				a.push(d)
+				return d
+			}
+		}
+		return 0
+	}
+
+	var flow N
+	maxW := MaxValue[N]()
+	for bfs() {
+		clear(next)
+		for {
+			f := dfs(s, maxW)
+			if f <= 0 {
+				break
+			}
+			flow += f
+		}
+	}
+	return flow
+}
+
+// Implementation of the FIFO push-relabel algorithm
+func (fn *flowNetwork[N]) pushRelabel(s, t int) N {
+	n := len(fn.arcs)
+	height := make([]int, n)
+	excess := make([]N, n)
+	current := make([]int, n)
+	active := make([]bool, n)
+	var q []int
+
+	push := func(a *flowArc[N], f N) {
+		a.push(f)
+		excess[a.from] -= f
+		excess[a.to] += f
+		if !active[a.to] && a.to != s && a.to != t {
+			active[a.to] = true
+			q = append(q, a.to)
+		}
+	}
+
+	height[s] = n
+	for _, a := range fn.arcs[s] {
+		if a.residual > 0 {
+			excess[s] += a.residual
+			push(a, a.residual)
+		}
+	}
+
+	for len(q) > 0 {
+		u := q[0]
+		q = q[1:]
+		active[u] = false
+
+		// Discharge u
+		for excess[u] > 0 {
+			if current[u] == len(fn.arcs[u]) {
+				// Relabel
+				h := 2 * n
+				for _, a := range fn.arcs[u] {
+					if a.residual > 0 {
+						h = min(h, height[a.to])
+					}
+				}
+				if h >= 2*n-1 {
+					// Heights never exceed 2n-1 with exact arithmetic, so the
+					// excess is a floating-point rounding error that cannot
+					// be returned to the source
+					excess[u] = 0
+					break
+				}
+				height[u] = h + 1
+				current[u] = 0
+				continue
+			}
+
+			a := fn.arcs[u][current[u]]
+			if a.residual > 0 && height[u] == height[a.to]+1 {
+				push(a, min(excess[u], a.residual))
+			} else {
+				current[u]++
+			}
+		}
+	}
+	return excess[t]
+}
@@ -0,0 +1,187 @@
+package edsger
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func CLRSFlowNetwork() *Graph[string, int] {
+	// Source: Introduction to Algorithms, Figure 26.1
+	g := NewDirectedGraph[string, int]()
+	for _, n := range []string{"s", "v1", "v2", "v3", "v4", "t"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("s", "v1", 16)
+	g.AddEdge("s", "v2", 13)
+	g.AddEdge("v2", "v1", 4)
+	g.AddEdge("v1", "v3", 12)
+	g.AddEdge("v3", "v2", 9)
+	g.AddEdge("v2", "v4", 14)
+	g.AddEdge("v4", "v3", 7)
+	g.AddEdge("v3", "t", 20)
+	g.AddEdge("v4", "t", 4)
+	return g
+}
+
+var maxFlowAlgorithms = map[string]MaxFlowAlgorithm{
+	"Dinic":       Dinic,
+	"PushRelabel": PushRelabel,
+}
+
+func validateFlow[T comparable, N Number](t *testing.T, g *Graph[T, N], source, sink T, value N, flows []*WeightedEdge[T, N]) {
+	balance := make(map[T]N)
+	for _, e := range flows {
+		c, ok := g.GetEdge(e.From, e.To)
+		if !ok {
+			t.Fatal("Invalid edge:", e)
+		}
+		if e.Weight > c {
+			t.Fatal("Capacity exceeded:", e, c)
+		}
+		balance[e.From] -= e.Weight
+		balance[e.To] += e.Weight
+	}
+	for n, b := range balance {
+		switch n {
+		case source:
+			if b != -value {
+				t.Fatal("Invalid source balance:", b)
+			}
+		case sink:
+			if b != value {
+				t.Fatal("Invalid sink balance:", b)
+			}
+		default:
+			if b != 0 {
+				t.Fatal("Flow is not conserved at node", n, b)
+			}
+		}
+	}
+}
+
+func validateCut[T comparable, N Number](t *testing.T, source, sink T, value N, cut []*WeightedEdge[T, N], sourceSide map[T]bool) {
+	if !sourceSide[source] || sourceSide[sink] {
+		t.Fatal("Invalid partition:", sourceSide)
+	}
+	var sum N
+	for _, e := range cut {
+		if !sourceSide[e.From] || sourceSide[e.To] {
+			t.Fatal("Invalid cut edge:", e)
+		}
+		sum += e.Weight
+	}
+	if sum != value {
+		t.Fatal("Invalid cut value:", sum, value)
+	}
+}
+
+func TestMaxFlowCLRS(t *testing.T) {
+	g := CLRSFlowNetwork()
+	for name, algorithm := range maxFlowAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			value, flows, err := g.MaxFlowWithAlgorithm("s", "t", algorithm)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			if value != 23 {
+				t.Fatal("Invalid max flow:", value)
+			}
+			validateFlow(t, g, "s", "t", value, flows)
+
+			value, cut, sourceSide, err := g.MinCutWithAlgorithm("s", "t", algorithm)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			t.Log(cut, sourceSide)
+			validateCut(t, "s", "t", value, cut, sourceSide)
+		})
+	}
+}
+
+func TestMaxFlowRandomGraphs(t *testing.T) {
+	for seed := range int64(10) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 30, 150, 0, 20, seed)
+
+			dinic, flows, err := g.MaxFlowWithAlgorithm(0, 1, Dinic)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			validateFlow(t, g, 0, 1, dinic, flows)
+
+			pushRelabel, flows, err := g.MaxFlowWithAlgorithm(0, 1, PushRelabel)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			validateFlow(t, g, 0, 1, pushRelabel, flows)
+
+			if dinic != pushRelabel {
+				t.Fatal("Max flows differ:", dinic, pushRelabel)
+			}
+
+			value, cut, sourceSide, err := g.MinCut(0, 1)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			if value != dinic {
+				t.Fatal("Invalid min cut:", value, dinic)
+			}
+			validateCut(t, 0, 1, value, cut, sourceSide)
+		}
+	}
+}
+
+func TestMaxFlowFloatCapacities(t *testing.T) {
+	for seed := range int64(10) {
+		// Random DAG with float capacities, where rounding errors may leave
+		// excess at nodes that cannot reach the source
+		g := NewDirectedGraph[int, float64]()
+		for i := range 10 {
+			g.AddNode(i)
+		}
+		r := rand.New(rand.NewSource(seed))
+		for u := range 10 {
+			for v := u + 1; v < 10; v++ {
+				if r.Float64() < 0.5 {
+					g.AddEdge(u, v, r.Float64())
+				}
+			}
+		}
+
+		dinic, _, err := g.MaxFlowWithAlgorithm(0, 9, Dinic)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		pushRelabel, _, err := g.MaxFlowWithAlgorithm(0, 9, PushRelabel)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if math.Abs(dinic-pushRelabel) > 1e-9 {
+			t.Fatal("Max flows differ:", dinic, pushRelabel)
+		}
+
+		value, _, sourceSide, err := g.MinCutWithAlgorithm(0, 9, PushRelabel)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if !sourceSide[0] || sourceSide[9] || math.Abs(value-dinic) > 1e-9 {
+			t.Fatal("Invalid min cut:", value, dinic, sourceSide)
+		}
+	}
+}
+
+func TestMaxFlowErrors(t *testing.T) {
+	g := CLRSFlowNetwork()
+	if _, _, err := g.MaxFlow("s", "s"); !errors.Is(err, ErrSameSourceSink) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, _, err := g.MaxFlow("s", "x"); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	g.UpdateEdge("s", "v1", -1)
+	if _, _, err := g.MaxFlow("s", "t"); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
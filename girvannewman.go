@@ -0,0 +1,89 @@
+package edsger
+
+// Iterator over the partitions of the Girvan-Newman community detection
+// algorithm
+type GirvanNewmanIterator[T comparable, N Number] struct {
+	g          *Graph[T, N]
+	components [][]T
+}
+
+// Returns an iterator over the partitions found by the Girvan-Newman
+// algorithm. The edge with the highest edge betweenness centrality is
+// repeatedly removed from a copy of the graph, and a partition into connected
+// components (weakly connected for directed graphs) is yielded each time the
+// number of components increases. Edge weights are used as distances.
+func (g *Graph[T, N]) GirvanNewman() *GirvanNewmanIterator[T, N] {
+	return must(g.TryGirvanNewman())
+}
+
+// Same as GirvanNewman but returns an error instead of panicking
+func (g *Graph[T, N]) TryGirvanNewman() (*GirvanNewmanIterator[T, N], error) {
+	if err := g.checkNonNegativeWeights(); err != nil {
+		return nil, err
+	}
+	it := &GirvanNewmanIterator[T, N]{g: g.Clone()}
+	// Self-loops do not connect components and are not yielded by Edges()
+	for node := range it.g.nodes {
+		it.g.removeEdge(node, node)
+	}
+	it.components = it.g.components()
+	return it, nil
+}
+
+// Returns the connected components of undirected graphs and the weakly
+// connected components of directed graphs
+func (g *Graph[T, N]) components() [][]T {
+	if g.directed {
+		components, _ := must2(g.WeaklyConnectedComponents())
+		return components
+	}
+	components, _ := must2(g.ConnectedComponents())
+	return components
+}
+
+func (it *GirvanNewmanIterator[T, N]) Next() bool {
+	numComponents := len(it.components)
+	for it.g.NumberOfEdges() > 0 {
+		if !it.removeMostValuableEdge() {
+			return false
+		}
+		if components := it.g.components(); len(components) > numComponents {
+			it.components = components
+			return true
+		}
+	}
+	return false
+}
+
+// Removes the edge with the highest edge betweenness centrality. Ties are
+// broken using the node indices. Returns false if there is no edge to remove.
+func (it *GirvanNewmanIterator[T, N]) removeMostValuableEdge() bool {
+	scores := must(it.g.EdgeBetweennessCentrality(BetweennessOptions{}))
+	if len(scores) == 0 {
+		return false
+	}
+
+	var best WeightedEdge[T, N]
+	bestScore := -1.0
+	for e, score := range scores {
+		if score > bestScore+1e-9 || (score > bestScore-1e-9 && it.lessEdge(e, best)) {
+			best = e
+			bestScore = score
+		}
+	}
+	it.g.RemoveEdge(best.From, best.To)
+	return true
+}
+
+func (it *GirvanNewmanIterator[T, N]) lessEdge(a, b WeightedEdge[T, N]) bool {
+	nodes := it.g.nodes
+	if nodes[a.From] != nodes[b.From] {
+		return nodes[a.From] < nodes[b.From]
+	}
+	return nodes[a.To] < nodes[b.To]
+}
+
+// Returns the current partition of the graph into communities
+func (it *GirvanNewmanIterator[T, N]) Get() [][]T {
+	return it.components
+}
@@ -0,0 +1,140 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Returns the partition with sorted communities in sorted order
+func sortedPartition(partition [][]int) [][]int {
+	res := make([][]int, len(partition))
+	for i, c := range partition {
+		res[i] = slices.Sorted(slices.Values(c))
+	}
+	slices.SortFunc(res, func(a, b []int) int { return a[0] - b[0] })
+	return res
+}
+
+func TestGirvanNewmanBridge(t *testing.T) {
+	// Two triangles connected by the bridge (2, 3)
+	g := NewUndirectedGraph[int, int]()
+	for i := range 6 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 0, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 3, 1)
+	g.AddEdge(2, 3, 1)
+
+	it := g.GirvanNewman()
+	if !it.Next() {
+		t.Fatal("Expected a partition")
+	}
+	if p := sortedPartition(it.Get()); !slices.EqualFunc(p, [][]int{{0, 1, 2}, {3, 4, 5}}, slices.Equal) {
+		t.Fatalf("Invalid partition: %v", p)
+	}
+
+	// Partitions are refined until each node is its own community
+	n := 2
+	for it.Next() {
+		if len(it.Get()) <= n {
+			t.Fatalf("Expected more than %d communities, got %d", n, len(it.Get()))
+		}
+		n = len(it.Get())
+	}
+	if n != 6 {
+		t.Fatalf("Expected 6 communities, got %d", n)
+	}
+
+	// The original graph is not modified
+	if g.NumberOfEdges() != 7 {
+		t.Fatalf("Graph was modified: %d edges", g.NumberOfEdges())
+	}
+}
+
+func TestGirvanNewmanKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	it := g.GirvanNewman()
+	if !it.Next() {
+		t.Fatal("Expected a partition")
+	}
+	// Reference partition from networkx.community.girvan_newman
+	expected := [][]int{
+		{0, 1, 3, 4, 5, 6, 7, 10, 11, 12, 13, 16, 17, 19, 21},
+		{2, 8, 9, 14, 15, 18, 20, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33},
+	}
+	if p := sortedPartition(it.Get()); !slices.EqualFunc(p, expected, slices.Equal) {
+		t.Fatalf("Invalid partition: %v", p)
+	}
+}
+
+func TestGirvanNewmanDirected(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 0, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 2, 1)
+
+	it := g.GirvanNewman()
+	if !it.Next() {
+		t.Fatal("Expected a partition")
+	}
+	if p := sortedPartition(it.Get()); !slices.EqualFunc(p, [][]int{{0, 1}, {2, 3}}, slices.Equal) {
+		t.Fatalf("Invalid partition: %v", p)
+	}
+}
+
+func TestGirvanNewmanNegativeWeight(t *testing.T) {
+	g := NewUndirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, -1)
+
+	if _, err := g.TryGirvanNewman(); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestGirvanNewmanSelfLoops(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		// Self-loops on nodes other than the zero value, which exists or not
+		for _, first := range []int{0, 1} {
+			var g *Graph[int, int]
+			if directed {
+				g = NewDirectedGraph[int, int]()
+			} else {
+				g = NewUndirectedGraph[int, int]()
+			}
+			for i := first; i < first+3; i++ {
+				g.AddNode(i)
+			}
+			g.AddEdge(first, first+1, 1)
+			g.AddEdge(first+1, first+1, 1)
+			g.AddEdge(first+2, first+2, 1)
+
+			it := g.GirvanNewman()
+			if !it.Next() {
+				t.Fatal("Expected a partition")
+			}
+			expected := [][]int{{first}, {first + 1}, {first + 2}}
+			if p := sortedPartition(it.Get()); !slices.EqualFunc(p, expected, slices.Equal) {
+				t.Fatalf("Invalid partition: %v", p)
+			}
+			if it.Next() {
+				t.Fatalf("Unexpected partition: %v", it.Get())
+			}
+			if _, ok := g.GetEdge(first+1, first+1); !ok {
+				t.Fatal("Self-loop removed from the original graph")
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package edsger
 
 import (
+	"fmt"
 	"iter"
 	"maps"
 	"slices"
@@ -75,10 +76,18 @@ func (g *Graph[T, N]) NumberOfEdges() int {
 }
 
 func (g *Graph[T, N]) AddNode(n T) {
+	if err := g.TryAddNode(n); err != nil {
+		panic(err)
+	}
+}
+
+// Adds node n to the graph. Returns ErrNodeExists if n is already in the graph.
+func (g *Graph[T, N]) TryAddNode(n T) error {
 	if g.HasNode(n) {
-		panic("Node already in graph!")
+		return fmt.Errorf("%w: %v", ErrNodeExists, n)
 	}
 	g.nodes[n] = len(g.nodes)
+	return nil
 }
 
 func (g *Graph[T, N]) HasNode(n T) bool {
@@ -87,18 +96,29 @@ func (g *Graph[T, N]) HasNode(n T) bool {
 }
 
 func (g *Graph[T, N]) AddEdge(source, dest T, weight N) {
-	if g.HasEdge(source, dest) {
-		panic("Edge already defined")
+	if err := g.TryAddEdge(source, dest, weight); err != nil {
+		panic(err)
+	}
+}
+
+// Adds an edge between source and dest. Returns ErrNodeNotFound if one of
+// the nodes is not in the graph and ErrEdgeExists if the edge already exists.
+func (g *Graph[T, N]) TryAddEdge(source, dest T, weight N) error {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return err
+	}
+	if _, ok := g.getEdge(source, dest); ok {
+		return fmt.Errorf("%w: (%v, %v)", ErrEdgeExists, source, dest)
 	}
 
 	g.addEdge(source, dest, weight)
 	if !g.directed {
 		g.addEdge(dest, source, weight)
 	}
+	return nil
 }
 
 func (g *Graph[T, N]) addEdge(source, dest T, weight N) {
-	g.validatePathNodes(source, dest)
 	g.edges[source] = append(g.edges[source], &NodeWeight[T, N]{
 		Node:   dest,
 		Weight: weight,
@@ -107,6 +127,24 @@ func (g *Graph[T, N]) addEdge(source, dest T, weight N) {
 
 func (g *Graph[T, N]) GetEdge(source, dest T) (N, bool) {
 	g.validatePathNodes(source, dest)
+	return g.getEdge(source, dest)
+}
+
+// Returns the weight of the edge between source and dest. Returns
+// ErrNodeNotFound if one of the nodes is not in the graph and ErrEdgeNotFound
+// if there is no such edge.
+func (g *Graph[T, N]) TryGetEdge(source, dest T) (N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return N(0), err
+	}
+	w, ok := g.getEdge(source, dest)
+	if !ok {
+		return N(0), fmt.Errorf("%w: (%v, %v)", ErrEdgeNotFound, source, dest)
+	}
+	return w, nil
+}
+
+func (g *Graph[T, N]) getEdge(source, dest T) (N, bool) {
 	for _, edge := range g.edges[source] {
 		if edge.Node == dest {
 			return edge.Weight, true
@@ -121,14 +159,25 @@ func (g *Graph[T, N]) HasEdge(source, dest T) bool {
 }
 
 func (g *Graph[T, N]) UpdateEdge(source, dest T, newWeight N) {
-	g.validatePathNodes(source, dest)
+	if err := g.TryUpdateEdge(source, dest, newWeight); err != nil {
+		panic(err)
+	}
+}
+
+// Updates the weight of the edge between source and dest. Returns
+// ErrNodeNotFound if one of the nodes is not in the graph and ErrEdgeNotFound
+// if there is no such edge.
+func (g *Graph[T, N]) TryUpdateEdge(source, dest T, newWeight N) error {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return err
+	}
 	for _, edge := range g.edges[source] {
 		if edge.Node == dest {
 			edge.Weight = newWeight
-			return
+			return nil
 		}
 	}
-	panic("Edge not found")
+	return fmt.Errorf("%w: (%v, %v)", ErrEdgeNotFound, source, dest)
 }
 
 // For undirected graphs: returns a slices of all neighbors of node n
@@ -191,19 +240,50 @@ func (g *Graph[T, N]) AllPredecessors() map[T]map[T]bool {
 }
 
 func (g *Graph[T, N]) validatePathNodes(source, dest T) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		panic(err)
+	}
+}
+
+func (g *Graph[T, N]) checkPathNodes(source, dest T) error {
 	if !g.HasNode(source) {
-		panic("Invalid source node")
+		return fmt.Errorf("invalid source node %v: %w", source, ErrNodeNotFound)
 	}
 	if !g.HasNode(dest) {
-		panic("Invalid destination node")
+		return fmt.Errorf("invalid destination node %v: %w", dest, ErrNodeNotFound)
 	}
+	return nil
 }
 
-func (g *Graph[T, N]) RemoveNode(node T) {
+func (g *Graph[T, N]) checkNode(node T) error {
 	if !g.HasNode(node) {
-		panic("Invalid node")
+		return fmt.Errorf("invalid node %v: %w", node, ErrNodeNotFound)
+	}
+	return nil
+}
+
+func (g *Graph[T, N]) RemoveNode(node T) {
+	if err := g.TryRemoveNode(node); err != nil {
+		panic(err)
+	}
+}
+
+// Removes node and all its edges from the graph. Returns ErrNodeNotFound if
+// the node is not in the graph. The indices of the nodes added after node are
+// decremented to keep them contiguous, such that nodes added later do not
+// reuse an existing index. Removal therefore takes O(V+E) time.
+func (g *Graph[T, N]) TryRemoveNode(node T) error {
+	if err := g.checkNode(node); err != nil {
+		return err
 	}
+	// Keep node indices contiguous
+	idx := g.nodes[node]
 	delete(g.nodes, node)
+	for n, i := range g.nodes {
+		if i > idx {
+			g.nodes[n] = i - 1
+		}
+	}
 	delete(g.edges, node)
 
 	for other, edges := range g.edges {
@@ -211,18 +291,30 @@ func (g *Graph[T, N]) RemoveNode(node T) {
 			return e.Node == node
 		})
 	}
+	return nil
 }
 
 func (g *Graph[T, N]) RemoveEdge(source, dest T) {
+	if err := g.TryRemoveEdge(source, dest); err != nil {
+		panic(err)
+	}
+}
+
+// Removes the edge between source and dest. Removing an edge which does not
+// exist is a no-op. Returns ErrNodeNotFound if one of the nodes is not in the
+// graph.
+func (g *Graph[T, N]) TryRemoveEdge(source, dest T) error {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return err
+	}
 	g.removeEdge(source, dest)
 	if !g.directed {
 		g.removeEdge(dest, source)
 	}
+	return nil
 }
 
 func (g *Graph[T, N]) removeEdge(source, dest T) {
-	g.validatePathNodes(source, dest)
-
 	g.edges[source] = slices.DeleteFunc(g.edges[source], func(e *NodeWeight[T, N]) bool {
 		return e.Node == dest
 	})
@@ -1,7 +1,11 @@
 package edsger
 
 import (
+	"errors"
 	"iter"
+	"maps"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -22,6 +26,28 @@ func FullyConnectedGraph(n int) *Graph[int, int] {
 	return g
 }
 
+// Random graph with n nodes and up to m edges with weights in [minW, maxW]
+func RandomGraph(directed bool, n, m, minW, maxW int, seed int64) *Graph[int, int] {
+	var g *Graph[int, int]
+	if directed {
+		g = NewDirectedGraph[int, int]()
+	} else {
+		g = NewUndirectedGraph[int, int]()
+	}
+	for i := range n {
+		g.AddNode(i)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for range m {
+		src, dst := r.Intn(n), r.Intn(n)
+		if src != dst && !g.HasEdge(src, dst) {
+			g.AddEdge(src, dst, minW+r.Intn(maxW-minW+1))
+		}
+	}
+	return g
+}
+
 func WikipediaGraph() *Graph[int, int] {
 	// Source: https://en.wikipedia.org/wiki/Dijkstra%27s_algorithm
 	g := NewUndirectedGraph[int, int]()
@@ -283,6 +309,18 @@ func TestRemoveNode(t *testing.T) {
 	if g.NumberOfEdges() != 6 {
 		t.Fatalf("Invalid number of edges: %v", g.NumberOfEdges())
 	}
+
+	// Node indices must stay contiguous
+	g.AddNode(7)
+	if !slices.Contains(g.NodesList(), 7) {
+		t.Fatal("Invalid nodes list:", g.NodesList())
+	}
+	indices := slices.Sorted(maps.Values(g.nodes))
+	for i, idx := range indices {
+		if i != idx {
+			t.Fatal("Invalid node indices:", g.nodes)
+		}
+	}
 }
 
 func TestRemoveEdge(t *testing.T) {
@@ -328,6 +366,20 @@ func TestNodeIterator(t *testing.T) {
 	}
 }
 
+func validatePath[T comparable, N Number](t *testing.T, g *Graph[T, N], path []T, total N) {
+	var sum N
+	for i := range len(path) - 1 {
+		w, ok := g.GetEdge(path[i], path[i+1])
+		if !ok {
+			t.Fatal("Invalid path:", path)
+		}
+		sum += w
+	}
+	if sum != total {
+		t.Fatal("Invalid path weight:", path, sum, total)
+	}
+}
+
 func validateCount[T any](t *testing.T, node T, seq iter.Seq[T], expected int) {
 	n := 0
 	for range seq {
@@ -370,3 +422,63 @@ func TestPredecessors(t *testing.T) {
 		}
 	}
 }
+
+func TestTryGraphOperations(t *testing.T) {
+	g := WikipediaGraph()
+
+	if err := g.TryAddNode(1); !errors.Is(err, ErrNodeExists) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryAddEdge(1, 2, 1); !errors.Is(err, ErrEdgeExists) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryAddEdge(1, 42, 1); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryAddEdge(42, 1, 1); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, err := g.TryGetEdge(1, 5); !errors.Is(err, ErrEdgeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryUpdateEdge(1, 5, 1); !errors.Is(err, ErrEdgeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryRemoveEdge(1, 42); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryRemoveNode(42); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	if err := g.TryAddNode(7); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryAddEdge(1, 7, 3); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryUpdateEdge(1, 7, 4); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if w, err := g.TryGetEdge(1, 7); err != nil || w != 4 {
+		t.Fatal("Unexpected result:", w, err)
+	}
+	if err := g.TryRemoveEdge(1, 7); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if err := g.TryRemoveNode(7); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestPanicWithError(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrNodeExists) {
+			t.Fatal("Unexpected panic value:", err)
+		}
+	}()
+
+	g := WikipediaGraph()
+	g.AddNode(1)
+}
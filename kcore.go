@@ -0,0 +1,109 @@
+package edsger
+
+// Returns the core number of each node, i.e. the largest k such that the node
+// belongs to the k-core of the graph. Degrees follow the semantics of
+// Degree(), i.e. the out-degree for directed graphs, but ignore self-loops.
+// Core numbers are computed in linear time using the algorithm of Batagelj
+// and Zaversnik.
+func (g *Graph[T, N]) CoreNumber() map[T]int {
+	nodes := g.NodesList()
+	n := len(nodes)
+	deg := make([]int, n)
+	maxDeg := 0
+	for node, d := range g.Degree() {
+		// Self-loops are never removed and do not count towards the degree
+		for _, e := range g.edges[node] {
+			if e.Node == node {
+				d--
+			}
+		}
+		deg[g.nodes[node]] = d
+		maxDeg = max(maxDeg, d)
+	}
+
+	// Sort the nodes by degree using bucket sort
+	bin := make([]int, maxDeg+2)
+	for _, d := range deg {
+		bin[d+1]++
+	}
+	for d := 1; d < len(bin); d++ {
+		bin[d] += bin[d-1]
+	}
+	pos := make([]int, n)
+	vert := make([]int, n)
+	for v, d := range deg {
+		pos[v] = bin[d]
+		vert[pos[v]] = v
+		bin[d]++
+	}
+	for d := len(bin) - 1; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	// Removing a node decreases the degree of the nodes with an edge to it
+	preds := g.reverseEdges()
+	for i := range n {
+		v := vert[i]
+		for _, e := range preds[nodes[v]] {
+			u := g.nodes[e.Node]
+			if u == v || deg[u] <= deg[v] {
+				continue
+			}
+
+			// Swap u with the first node of its bucket and shrink the bucket
+			du, pu := deg[u], pos[u]
+			pw := bin[du]
+			w := vert[pw]
+			if u != w {
+				pos[u], pos[w] = pw, pu
+				vert[pu], vert[pw] = w, u
+			}
+			bin[du]++
+			deg[u]--
+		}
+	}
+
+	res := make(map[T]int, n)
+	for i, node := range nodes {
+		res[node] = deg[i]
+	}
+	return res
+}
+
+// Returns the k-core of the graph, i.e. the maximal subgraph in which each
+// node has a degree of at least k without self-loops, as a new graph
+func (g *Graph[T, N]) KCore(k int) *Graph[T, N] {
+	core := g.CoreNumber()
+	return g.inducedSubgraph(func(node T) bool {
+		return core[node] >= k
+	})
+}
+
+// Returns a new graph with the nodes for which keep returns true and the
+// edges between them
+func (g *Graph[T, N]) inducedSubgraph(keep func(T) bool) *Graph[T, N] {
+	var res *Graph[T, N]
+	if g.directed {
+		res = NewDirectedGraph[T, N]()
+	} else {
+		res = NewUndirectedGraph[T, N]()
+	}
+	for _, node := range g.NodesList() {
+		if keep(node) {
+			res.AddNode(node)
+		}
+	}
+	for _, src := range g.NodesList() {
+		if !res.HasNode(src) {
+			continue
+		}
+		for _, e := range g.edges[src] {
+			// Edges of undirected graphs are already stored in both directions
+			if res.HasNode(e.Node) {
+				res.addEdge(src, e.Node, e.Weight)
+			}
+		}
+	}
+	return res
+}
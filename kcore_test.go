@@ -0,0 +1,118 @@
+package edsger
+
+import (
+	"slices"
+	"testing"
+)
+
+// Core numbers computed by repeatedly removing the nodes of minimum degree
+func bruteForceCoreNumber[T comparable, N Number](g *Graph[T, N]) map[T]int {
+	g = g.inducedSubgraph(func(T) bool { return true })
+	res := make(map[T]int)
+	for k := 0; g.NumberOfNodes() > 0; {
+		removed := false
+		for node := range g.Nodes() {
+			degree := 0
+			for _, e := range g.edges[node] {
+				if e.Node != node {
+					degree++
+				}
+			}
+			if degree <= k {
+				res[node] = k
+				g = g.inducedSubgraph(func(n T) bool { return n != node })
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			k++
+		}
+	}
+	return res
+}
+
+func TestCoreNumber(t *testing.T) {
+	g := BarbellGraph(5)
+	g.AddNode(10)
+	g.AddNode(11)
+	g.AddEdge(10, 11, 1)
+
+	core := g.CoreNumber()
+	for node, c := range core {
+		expected := 4
+		if node >= 10 {
+			expected = 1
+		}
+		if c != expected {
+			t.Fatalf("Invalid core number for %d: expected %d, got %d", node, expected, c)
+		}
+	}
+	if len(core) != 12 {
+		t.Fatalf("Expected 12 core numbers, got %d", len(core))
+	}
+}
+
+func TestCoreNumberKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	// Reference values from networkx.k_core
+	core := g.KCore(4)
+	nodes := slices.Sorted(core.Nodes())
+	if !slices.Equal(nodes, []int{0, 1, 2, 3, 7, 8, 13, 30, 32, 33}) {
+		t.Fatalf("Invalid 4-core: %v", nodes)
+	}
+	for node, d := range core.Degree() {
+		if d < 4 {
+			t.Fatalf("Node %d has degree %d in the 4-core", node, d)
+		}
+	}
+	if core.IsDirected() {
+		t.Fatal("Expected an undirected k-core")
+	}
+	if g.KCore(5).NumberOfNodes() != 0 {
+		t.Fatal("Expected an empty 5-core")
+	}
+}
+
+func TestCoreNumberBruteForce(t *testing.T) {
+	for seed := range int64(10) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 30, 120, 1, 1, seed)
+
+			core := g.CoreNumber()
+			expected := bruteForceCoreNumber(g)
+			for node, c := range expected {
+				if core[node] != c {
+					t.Fatalf("Invalid core number for %d: expected %d, got %d", node, c, core[node])
+				}
+			}
+		}
+	}
+}
+
+func TestCoreNumberSelfLoops(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		var g *Graph[int, int]
+		if directed {
+			g = NewDirectedGraph[int, int]()
+		} else {
+			g = NewUndirectedGraph[int, int]()
+		}
+		g.AddNode(0)
+		g.AddNode(1)
+		g.AddEdge(0, 1, 1)
+		if directed {
+			g.AddEdge(1, 0, 1)
+		}
+		g.AddEdge(0, 0, 1)
+
+		core := g.CoreNumber()
+		if core[0] != 1 || core[1] != 1 {
+			t.Fatalf("Invalid core numbers: %v", core)
+		}
+		if n := g.KCore(2).NumberOfNodes(); n != 0 {
+			t.Fatalf("Expected an empty 2-core, got %d nodes", n)
+		}
+	}
+}
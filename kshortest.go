@@ -0,0 +1,144 @@
+package edsger
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Iterator over the k shortest loopless paths between two nodes, based on
+// Yen's algorithm. Paths are returned in nondecreasing order of cost.
+type KShortestPathIterator[T comparable, N Number] struct {
+	g      *Graph[T, N]
+	source T
+	dest   T
+	k      int
+
+	// Paths already returned and candidate paths
+	paths      []*item[T, N]
+	candidates basicPriorityQueue[T, N]
+
+	// Returned value
+	path []T
+	cost N
+}
+
+// Returns an iterator over the k shortest loopless paths from source to dest
+func (g *Graph[T, N]) KShortestPaths(source, dest T, k int) *KShortestPathIterator[T, N] {
+	return must(g.TryKShortestPaths(source, dest, k))
+}
+
+// Same as KShortestPaths but returns an error instead of panicking. Returns
+// ErrNegativeWeight if any edge of the graph has a negative weight.
+func (g *Graph[T, N]) TryKShortestPaths(source, dest T, k int) (*KShortestPathIterator[T, N], error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, err
+	}
+	// Checked upfront such that the iteration cannot fail
+	if err := g.checkNonNegativeWeights(); err != nil {
+		return nil, err
+	}
+	return &KShortestPathIterator[T, N]{
+		g:      g,
+		source: source,
+		dest:   dest,
+		k:      k,
+	}, nil
+}
+
+func (it *KShortestPathIterator[T, N]) Next() bool {
+	if len(it.paths) >= it.k {
+		it.path, it.cost = nil, 0
+		return false
+	}
+
+	if len(it.paths) == 0 {
+		prev, dist, err := it.g.shortestPathMap(it.source, it.dest, false, nil, nil)
+		if err != nil {
+			panic(err)
+		}
+		if prev == nil {
+			it.k = 0
+			return false
+		}
+		path, _ := pathFromShortestPathMap(it.dest, prev, dist)
+		return it.accept(&item[T, N]{node: it.dest, path: path, cost: dist})
+	}
+
+	if err := it.addCandidates(it.paths[len(it.paths)-1]); err != nil {
+		panic(err)
+	}
+	if it.candidates.Len() == 0 {
+		it.k = len(it.paths)
+		it.path, it.cost = nil, 0
+		return false
+	}
+	return it.accept(heap.Pop(&it.candidates).(*item[T, N]))
+}
+
+func (it *KShortestPathIterator[T, N]) accept(p *item[T, N]) bool {
+	it.paths = append(it.paths, p)
+	it.path, it.cost = p.path, p.cost
+	return true
+}
+
+// Generates the candidate paths deviating from the last returned path
+func (it *KShortestPathIterator[T, N]) addCandidates(last *item[T, N]) error {
+	var rootCost N
+	for i := range len(last.path) - 1 {
+		spur := last.path[i]
+		root := last.path[:i+1]
+
+		// Remove the edges which are part of previous paths sharing the same root
+		excludedEdges := make(map[edgeKey[T]]bool)
+		for _, p := range it.paths {
+			if len(p.path) > i+1 && slices.Equal(p.path[:i+1], root) {
+				excludedEdges[edgeKey[T]{p.path[i], p.path[i+1]}] = true
+			}
+		}
+
+		// Remove the nodes of the root path except the spur node
+		excludedNodes := make(map[T]bool, i)
+		for _, n := range root[:i] {
+			excludedNodes[n] = true
+		}
+
+		prev, dist, err := it.g.shortestPathMap(spur, it.dest, false, excludedNodes, excludedEdges)
+		if err != nil {
+			return err
+		}
+		if prev != nil {
+			spurPath, _ := pathFromShortestPathMap(it.dest, prev, dist)
+			candidate := &item[T, N]{
+				node: it.dest,
+				path: slices.Concat(root[:i], spurPath),
+				cost: rootCost + dist,
+			}
+			if !it.known(candidate.path) {
+				heap.Push(&it.candidates, candidate)
+			}
+		}
+
+		w, _ := it.g.getEdge(last.path[i], last.path[i+1])
+		rootCost += w
+	}
+	return nil
+}
+
+// Returns true if the path was already returned or is already a candidate
+func (it *KShortestPathIterator[T, N]) known(path []T) bool {
+	for _, p := range it.paths {
+		if slices.Equal(p.path, path) {
+			return true
+		}
+	}
+	for _, p := range it.candidates {
+		if slices.Equal(p.path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (it *KShortestPathIterator[T, N]) Get() ([]T, N) {
+	return it.path, it.cost
+}
@@ -0,0 +1,98 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestKShortestPathsWikipedia(t *testing.T) {
+	// Source: https://en.wikipedia.org/wiki/Yen%27s_algorithm
+	g := NewDirectedGraph[string, int]()
+	for _, n := range []string{"C", "D", "E", "F", "G", "H"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("C", "D", 3)
+	g.AddEdge("C", "E", 2)
+	g.AddEdge("D", "F", 4)
+	g.AddEdge("E", "D", 1)
+	g.AddEdge("E", "F", 2)
+	g.AddEdge("E", "G", 3)
+	g.AddEdge("F", "G", 2)
+	g.AddEdge("F", "H", 1)
+	g.AddEdge("G", "H", 2)
+
+	// The third path is either C-D-F-H or C-E-F-G-H which have the same cost
+	expected := [][]string{
+		{"C", "E", "F", "H"},
+		{"C", "E", "G", "H"},
+	}
+	expectedCosts := []int{5, 7, 8}
+
+	i := 0
+	it := g.KShortestPaths("C", "H", 3)
+	for it.Next() {
+		path, cost := it.Get()
+		t.Log(path, cost)
+		if (i < len(expected) && !slices.Equal(path, expected[i])) || cost != expectedCosts[i] {
+			t.Fatal("Invalid path:", path, cost)
+		}
+		i++
+	}
+	if i != 3 {
+		t.Fatal("Invalid number of paths:", i)
+	}
+}
+
+func TestKShortestPathsAllSimplePaths(t *testing.T) {
+	g := WikipediaGraph()
+	source, dest := 1, 5
+
+	n := 0
+	it := g.AllSimplePaths(source, dest)
+	for it.Next() {
+		n++
+	}
+
+	var paths [][]int
+	var last int
+	kit := g.KShortestPaths(source, dest, 100)
+	for kit.Next() {
+		path, cost := kit.Get()
+		t.Log(path, cost)
+		if cost < last {
+			t.Fatal("Paths are not in nondecreasing order of cost")
+		}
+		last = cost
+		validatePath(t, g, path, cost)
+
+		for _, p := range paths {
+			if slices.Equal(p, path) {
+				t.Fatal("Duplicate path:", path)
+			}
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) != n {
+		t.Fatalf("Invalid number of paths: %d (expected %d)", len(paths), n)
+	}
+}
+
+func TestKShortestPathsNoPath(t *testing.T) {
+	g := NoPathGraph()
+	it := g.KShortestPaths(1, 5, 3)
+	if it.Next() {
+		t.Fatal("Unexpected path")
+	}
+}
+
+func TestKShortestPathsNegativeWeight(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, -1)
+
+	if _, err := g.TryKShortestPaths(0, 1, 2); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
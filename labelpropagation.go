@@ -0,0 +1,177 @@
+package edsger
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// Options of the label propagation community detection
+type LabelPropagationOptions struct {
+	// Updates the labels of non-adjacent nodes simultaneously instead of
+	// updating one node at a time in random order
+	Synchronous bool
+	// Uses the edge weights instead of considering all edges equal
+	Weighted bool
+	// Seed of the random number generator used to order the nodes and break
+	// ties between labels
+	Seed int64
+	// Maximum number of iterations over all nodes. Uses 100 if not set.
+	MaxIterations int
+}
+
+// Detects communities using label propagation. Each node starts with its own
+// label and repeatedly adopts the label with the largest weight among its
+// neighbors, until each node has such a label. Nodes with the same label form
+// a community. For directed graphs, the labels are propagated along the
+// reversed edges, i.e. nodes adopt the labels of their successors.
+//
+// In the asynchronous variant, nodes are updated one at a time in random
+// order. In the synchronous variant, the nodes are colored such that adjacent
+// nodes have different colors, and the nodes of each color are updated
+// simultaneously, following the semi-synchronous algorithm of Cordasco and
+// Gargano. Returns a map from each node to its community, or ErrNotConverged
+// if the labels do not stabilize within the maximum number of iterations.
+func (g *Graph[T, N]) LabelPropagationCommunities(opts LabelPropagationOptions) (map[T]int, error) {
+	adj, err := g.floatAdjacency(opts.Weighted)
+	if err != nil {
+		return nil, err
+	}
+	maxIterations := opts.MaxIterations
+	if maxIterations <= 0 {
+		maxIterations = 100
+	}
+
+	lp := &labelPropagation{
+		adj:     adj,
+		labels:  identity(len(adj)),
+		weights: make([]float64, len(adj)),
+		seen:    make([]bool, len(adj)),
+		rng:     rand.New(rand.NewSource(opts.Seed)),
+	}
+	var classes [][]int
+	if opts.Synchronous {
+		classes = greedyColoring(adj)
+	}
+
+	for range maxIterations {
+		if lp.stable() {
+			labels := lp.labels
+			relabel(labels)
+			res := make(map[T]int, len(labels))
+			for i, node := range g.NodesList() {
+				res[node] = labels[i]
+			}
+			return res, nil
+		}
+
+		if opts.Synchronous {
+			next := make([]int, len(adj))
+			for _, class := range classes {
+				for _, u := range class {
+					next[u] = lp.bestLabel(u)
+				}
+				for _, u := range class {
+					lp.labels[u] = next[u]
+				}
+			}
+		} else {
+			for _, u := range lp.rng.Perm(len(adj)) {
+				lp.labels[u] = lp.bestLabel(u)
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: label propagation after %d iterations", ErrNotConverged, maxIterations)
+}
+
+type labelPropagation struct {
+	adj    [][]indexedEdge[float64]
+	labels []int
+	// Weight of each label among the neighbors of the current node
+	weights    []float64
+	seen       []bool
+	candidates []int
+	best       []int
+	rng        *rand.Rand
+}
+
+// Returns the labels with the largest weight among the neighbors of u in
+// increasing order
+func (lp *labelPropagation) bestLabels(u int) []int {
+	lp.candidates = lp.candidates[:0]
+	for _, e := range lp.adj[u] {
+		l := lp.labels[e.to]
+		if !lp.seen[l] {
+			lp.seen[l] = true
+			lp.candidates = append(lp.candidates, l)
+		}
+		lp.weights[l] += e.weight
+	}
+
+	maxWeight := -1.0
+	lp.best = lp.best[:0]
+	for _, l := range lp.candidates {
+		if w := lp.weights[l]; w > maxWeight {
+			maxWeight = w
+			lp.best = append(lp.best[:0], l)
+		} else if w == maxWeight {
+			lp.best = append(lp.best, l)
+		}
+	}
+	for _, l := range lp.candidates {
+		lp.weights[l] = 0
+		lp.seen[l] = false
+	}
+	slices.Sort(lp.best)
+	return lp.best
+}
+
+// Returns the new label of u, keeping its current label when possible
+func (lp *labelPropagation) bestLabel(u int) int {
+	best := lp.bestLabels(u)
+	if len(best) == 0 || slices.Contains(best, lp.labels[u]) {
+		return lp.labels[u]
+	}
+	return best[lp.rng.Intn(len(best))]
+}
+
+// Returns whether each node has one of the labels with the largest weight
+// among its neighbors
+func (lp *labelPropagation) stable() bool {
+	for u := range lp.adj {
+		best := lp.bestLabels(u)
+		if len(best) > 0 && !slices.Contains(best, lp.labels[u]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Partitions the nodes into classes such that no edge connects two nodes of
+// the same class, using the greedy coloring in order of the node indices
+func greedyColoring[N Number](adj [][]indexedEdge[N]) [][]int {
+	in := transpose(adj)
+	color := make([]int, len(adj))
+	var classes [][]int
+	used := make(map[int]bool)
+	for u := range adj {
+		clear(used)
+		for _, edges := range [][]indexedEdge[N]{adj[u], in[u]} {
+			for _, e := range edges {
+				if e.to < u {
+					used[color[e.to]] = true
+				}
+			}
+		}
+		c := 0
+		for used[c] {
+			c++
+		}
+		color[u] = c
+		if c == len(classes) {
+			classes = append(classes, nil)
+		}
+		classes[c] = append(classes[c], u)
+	}
+	return classes
+}
@@ -0,0 +1,82 @@
+package edsger
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestLabelPropagationCommunitiesBarbell(t *testing.T) {
+	g := BarbellGraph(5)
+	g.AddNode(10)
+	expected := [][]int{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}, {10}}
+
+	for _, synchronous := range []bool{false, true} {
+		for seed := range int64(10) {
+			partition, err := g.LabelPropagationCommunities(LabelPropagationOptions{Synchronous: synchronous, Seed: seed})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := communities(partition)
+			for i := range c {
+				slices.Sort(c[i])
+			}
+			slices.SortFunc(c, func(a, b []int) int { return a[0] - b[0] })
+			if !slices.EqualFunc(c, expected, slices.Equal) {
+				t.Fatalf("Invalid communities with synchronous=%v: %v", synchronous, c)
+			}
+		}
+	}
+}
+
+func TestLabelPropagationCommunitiesWeighted(t *testing.T) {
+	// Node 2 is more strongly connected to node 3
+	g := NewUndirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(2, 3, 5)
+
+	partition, err := g.LabelPropagationCommunities(LabelPropagationOptions{Weighted: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partition[2] != partition[3] {
+		t.Fatalf("Expected nodes 2 and 3 in the same community: %v", partition)
+	}
+}
+
+func TestLabelPropagationCommunitiesKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	for _, synchronous := range []bool{false, true} {
+		opts := LabelPropagationOptions{Synchronous: synchronous, Seed: 42}
+		partition, err := g.LabelPropagationCommunities(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(partition) != 34 {
+			t.Fatalf("Expected 34 nodes, got %d", len(partition))
+		}
+		validateConnectedCommunities(t, g, partition)
+
+		// The same seed gives the same partition
+		again, _ := g.LabelPropagationCommunities(opts)
+		if !maps.Equal(partition, again) {
+			t.Fatal("Partition differs with the same seed")
+		}
+	}
+}
+
+func TestLabelPropagationCommunitiesNotConverged(t *testing.T) {
+	g := BarbellGraph(5)
+
+	_, err := g.LabelPropagationCommunities(LabelPropagationOptions{MaxIterations: 1})
+	if !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("Expected ErrNotConverged, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package edsger
+
+import "fmt"
+
+// Edge carrying a flow, with its capacity and cost per unit of flow
+type FlowEdge[T comparable, N Number] struct {
+	From     T
+	To       T
+	Capacity N
+	Cost     N
+	Flow     N
+}
+
+// Solves the minimum-cost flow problem using the successive shortest paths
+// algorithm with node potentials. Edge weights are costs per unit of flow and
+// the capacity of each edge is given by the capacity function. For undirected
+// graphs, each edge can be used in both directions and the capacity in each
+// direction is capacity(from, to) for the direction of the flow. Nodes with a
+// positive supply are sources and nodes with a negative supply are sinks. The
+// supplies must sum up to zero.
+//
+// Returns the edges carrying a positive flow and the total cost of the flow.
+// Returns ErrInfeasibleFlow if the supplies cannot be routed,
+// ErrNegativeCycle if the graph contains a cycle of negative cost and
+// ErrInexactCost if integer costs are too large to be computed exactly.
+func (g *Graph[T, N]) MinCostFlow(supplies map[T]N, capacity func(from, to T) N) ([]*FlowEdge[T, N], N, error) {
+	for n := range supplies {
+		if err := g.checkNode(n); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// Undirected edges are split into two arcs in opposite directions
+	var edges []*FlowEdge[T, N]
+	for e := range g.Edges() {
+		edges = append(edges, &FlowEdge[T, N]{From: e.From, To: e.To, Capacity: capacity(e.From, e.To), Cost: e.Weight})
+		if !g.directed {
+			edges = append(edges, &FlowEdge[T, N]{From: e.To, To: e.From, Capacity: capacity(e.To, e.From), Cost: e.Weight})
+		}
+	}
+	flows, cost, err := MinCostFlowEdges(supplies, edges)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var res []*FlowEdge[T, N]
+	step := 1
+	if !g.directed {
+		step = 2
+	}
+	for i := 0; i < len(flows); i += step {
+		e := flows[i]
+		if !g.directed {
+			// Flows in opposite directions cancel out
+			if b := flows[i+1]; b.Flow > e.Flow {
+				b.Flow -= e.Flow
+				e = b
+			} else {
+				e.Flow -= b.Flow
+			}
+		}
+		if e.Flow > 0 {
+			res = append(res, e)
+		}
+	}
+	return res, cost, nil
+}
+
+// Solves the minimum-cost flow problem on the directed network given by its
+// edges, each having its own capacity and cost per unit of flow. The Flow of
+// the given edges is ignored. Nodes with a positive supply are sources and
+// nodes with a negative supply are sinks. The supplies must sum up to zero.
+//
+// Returns a copy of the edges with their flow in the same order, and the
+// total cost of the flow. Returns ErrInfeasibleFlow if the supplies cannot be
+// routed, ErrNegativeCycle if the network contains a cycle of negative cost
+// and ErrInexactCost if integer costs are too large to be computed exactly.
+func MinCostFlowEdges[T comparable, N Number](supplies map[T]N, edges []*FlowEdge[T, N]) ([]*FlowEdge[T, N], N, error) {
+	var balance, total N
+	for _, s := range supplies {
+		balance += s
+		if s > 0 {
+			total += s
+		}
+	}
+	if balance != 0 {
+		return nil, 0, fmt.Errorf("%w: supplies sum up to %v", ErrUnbalancedSupplies, balance)
+	}
+
+	nodes := make(map[T]int)
+	index := func(node T) int {
+		i, ok := nodes[node]
+		if !ok {
+			i = len(nodes)
+			nodes[node] = i
+		}
+		return i
+	}
+	for _, e := range edges {
+		if e.Capacity < 0 {
+			return nil, 0, fmt.Errorf("%w: capacity of (%v, %v)", ErrNegativeWeight, e.From, e.To)
+		}
+		index(e.From)
+		index(e.To)
+	}
+	for node := range supplies {
+		index(node)
+	}
+
+	// Super source and super sink connected to the nodes with supplies
+	n := len(nodes)
+	s, t := n, n+1
+	fn := newFlowNetwork[N](n + 2)
+	for node, supply := range supplies {
+		if supply > 0 {
+			fn.addArc(s, nodes[node], supply, 0)
+		} else if supply < 0 {
+			fn.addArc(nodes[node], t, -supply, 0)
+		}
+	}
+	arcs := make([]*flowArc[N], len(edges))
+	for i, e := range edges {
+		arcs[i] = fn.addArc(nodes[e.From], nodes[e.To], e.Capacity, e.Cost)
+	}
+
+	flow, err := fn.successiveShortestPaths(s, t, total)
+	if err != nil {
+		return nil, 0, err
+	}
+	if flow < total {
+		return nil, 0, fmt.Errorf("%w: only %v of %v units can be routed", ErrInfeasibleFlow, flow, total)
+	}
+
+	var cost N
+	res := make([]*FlowEdge[T, N], len(edges))
+	for i, e := range edges {
+		res[i] = &FlowEdge[T, N]{
+			From:     e.From,
+			To:       e.To,
+			Capacity: e.Capacity,
+			Cost:     e.Cost,
+			Flow:     arcs[i].flow(),
+		}
+		cost += res[i].Flow * e.Cost
+	}
+	return res, cost, nil
+}
@@ -0,0 +1,222 @@
+package edsger
+
+import (
+	"errors"
+	"testing"
+)
+
+func validateMinCostFlow[T comparable, N Number](t *testing.T, g *Graph[T, N], supplies map[T]N, flows []*FlowEdge[T, N], cost N) {
+	balance := make(map[T]N)
+	var sum N
+	for _, e := range flows {
+		w, ok := g.GetEdge(e.From, e.To)
+		if !ok || w != e.Cost {
+			t.Fatal("Invalid edge:", e)
+		}
+		if e.Flow > e.Capacity {
+			t.Fatal("Capacity exceeded:", e)
+		}
+		balance[e.From] += e.Flow
+		balance[e.To] -= e.Flow
+		sum += e.Flow * e.Cost
+	}
+	for n := range g.Nodes() {
+		if balance[n] != supplies[n] {
+			t.Fatal("Invalid balance at node", n, balance[n], supplies[n])
+		}
+	}
+	if sum != cost {
+		t.Fatal("Invalid cost:", sum, cost)
+	}
+}
+
+func TestMinCostFlow(t *testing.T) {
+	g := NewDirectedGraph[string, int]()
+	for _, n := range []string{"s", "a", "b", "t"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("s", "a", 1)
+	g.AddEdge("s", "b", 5)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "t", 6)
+	g.AddEdge("b", "t", 1)
+
+	capacities := map[[2]string]int{
+		{"s", "a"}: 4,
+		{"s", "b"}: 2,
+		{"a", "b"}: 2,
+		{"a", "t"}: 3,
+		{"b", "t"}: 5,
+	}
+	capacity := func(from, to string) int {
+		return capacities[[2]string{from, to}]
+	}
+
+	supplies := map[string]int{"s": 5, "t": -5}
+	flows, cost, err := g.MinCostFlow(supplies, capacity)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	t.Log(flows, cost)
+
+	// 2 units via s-a-b-t, 1 unit via s-a-t and 2 units via s-b-t
+	if cost != 2*3+1*7+2*6 {
+		t.Fatal("Invalid cost:", cost)
+	}
+	validateMinCostFlow(t, g, supplies, flows, cost)
+
+	if _, _, err := g.MinCostFlow(map[string]int{"s": 10, "t": -10}, capacity); !errors.Is(err, ErrInfeasibleFlow) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, _, err := g.MinCostFlow(map[string]int{"s": 1}, capacity); !errors.Is(err, ErrUnbalancedSupplies) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestMinCostFlowNegativeCosts(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 2)
+	g.AddEdge(0, 2, 4)
+	g.AddEdge(1, 3, 3)
+	g.AddEdge(2, 3, -3)
+
+	unit := func(int, int) int { return 1 }
+	supplies := map[int]int{0: 1, 3: -1}
+	flows, cost, err := g.MinCostFlow(supplies, unit)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if cost != 1 {
+		t.Fatal("Invalid cost:", cost)
+	}
+	validateMinCostFlow(t, g, supplies, flows, cost)
+
+	g.AddEdge(3, 0, -5)
+	if _, _, err := g.MinCostFlow(supplies, unit); !errors.Is(err, ErrNegativeCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestMinCostFlowDisjointPaths(t *testing.T) {
+	// With unit capacities, the minimum-cost flow of value k corresponds to
+	// the k edge-disjoint paths of minimal total cost
+	for seed := range int64(5) {
+		for _, directed := range []bool{true, false} {
+			g := RandomGraph(directed, 30, 150, 1, 10, seed)
+			paths, total, err := g.EdgeDisjointShortestPaths(0, 1, 3)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+
+			k := len(paths)
+			supplies := map[int]int{0: k, 1: -k}
+			flows, cost, err := g.MinCostFlow(supplies, func(int, int) int { return 1 })
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			if cost != total {
+				t.Fatal("Invalid cost:", cost, total)
+			}
+			validateMinCostFlow(t, g, supplies, flows, cost)
+		}
+	}
+}
+
+func TestMinCostFlowUndirectedCapacity(t *testing.T) {
+	for _, reversed := range []bool{false, true} {
+		g := NewUndirectedGraph[string, int]()
+		g.AddNode("s")
+		g.AddNode("t")
+		if reversed {
+			g.AddEdge("t", "s", 3)
+		} else {
+			g.AddEdge("s", "t", 3)
+		}
+
+		// The capacity is only defined in the direction of the flow
+		capacity := func(from, to string) int {
+			return map[[2]string]int{{"s", "t"}: 2}[[2]string{from, to}]
+		}
+		supplies := map[string]int{"s": 2, "t": -2}
+		flows, cost, err := g.MinCostFlow(supplies, capacity)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if cost != 6 || len(flows) != 1 || flows[0].From != "s" || flows[0].Flow != 2 || flows[0].Capacity != 2 {
+			t.Fatalf("Invalid flow: %v %d", flows, cost)
+		}
+		validateMinCostFlow(t, g, supplies, flows, cost)
+
+		if _, _, err := g.MinCostFlow(map[string]int{"s": -1, "t": 1}, capacity); !errors.Is(err, ErrInfeasibleFlow) {
+			t.Fatal("Unexpected error:", err)
+		}
+	}
+}
+
+func TestMinCostFlowEdges(t *testing.T) {
+	// Parallel edges with different capacities and costs
+	edges := []*FlowEdge[string, int]{
+		{From: "s", To: "t", Capacity: 2, Cost: 1},
+		{From: "s", To: "t", Capacity: 5, Cost: 3},
+		{From: "s", To: "a", Capacity: 1, Cost: 1},
+		{From: "a", To: "t", Capacity: 1, Cost: 1},
+	}
+	flows, cost, err := MinCostFlowEdges(map[string]int{"s": 4, "t": -4}, edges)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if cost != 2+2+3 {
+		t.Fatal("Invalid cost:", cost)
+	}
+	for i, f := range []int{2, 1, 1, 1} {
+		if flows[i].Flow != f || flows[i].From != edges[i].From || flows[i].Cost != edges[i].Cost {
+			t.Fatalf("Invalid flow on edge %d: %v", i, flows[i])
+		}
+	}
+	if edges[0].Flow != 0 {
+		t.Fatal("Input edges were modified")
+	}
+
+	if _, _, err := MinCostFlowEdges(map[string]int{"s": 9, "t": -9}, edges); !errors.Is(err, ErrInfeasibleFlow) {
+		t.Fatal("Unexpected error:", err)
+	}
+	edges[0].Capacity = -1
+	if _, _, err := MinCostFlowEdges(map[string]int{"s": 1, "t": -1}, edges); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestMinCostFlowInexactCosts(t *testing.T) {
+	// Costs above 2^53 are not exactly represented by float64
+	edges := []*FlowEdge[string, int64]{
+		{From: "s", To: "t", Capacity: 1, Cost: 1<<53 + 1},
+	}
+	if _, _, err := MinCostFlowEdges(map[string]int64{"s": 1, "t": -1}, edges); !errors.Is(err, ErrInexactCost) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	// Exact costs whose sum is too large for exact potentials
+	edges[0].Cost = 1 << 52
+	if _, _, err := MinCostFlowEdges(map[string]int64{"s": 1, "t": -1}, edges); !errors.Is(err, ErrInexactCost) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	g := NewDirectedGraph[int, int64]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, 1<<53+1)
+	if _, _, err := g.EdgeDisjointShortestPaths(0, 1, 1); !errors.Is(err, ErrInexactCost) {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	// Large float costs are allowed
+	floats := []*FlowEdge[string, float64]{
+		{From: "s", To: "t", Capacity: 1, Cost: 1e20},
+	}
+	if _, cost, err := MinCostFlowEdges(map[string]float64{"s": 1, "t": -1}, floats); err != nil || cost != 1e20 {
+		t.Fatal("Unexpected result:", cost, err)
+	}
+}
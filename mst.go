@@ -0,0 +1,241 @@
+package edsger
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Algorithm used to compute spanning forests
+type SpanningForestAlgorithm int
+
+const (
+	// Kruskal's algorithm based on a union-find data structure
+	Kruskal SpanningForestAlgorithm = iota
+	// Prim's algorithm based on a priority queue
+	Prim
+	// Parallel variant of Borůvka's algorithm
+	Boruvka
+)
+
+// Computes a minimum spanning forest of an undirected graph using Kruskal's
+// algorithm. Returns the edges of the forest and its total weight.
+func (g *Graph[T, N]) MinimumSpanningForest() ([]*WeightedEdge[T, N], N, error) {
+	return g.MinimumSpanningForestWithAlgorithm(Kruskal)
+}
+
+// Same as MinimumSpanningForest using the given algorithm
+func (g *Graph[T, N]) MinimumSpanningForestWithAlgorithm(algorithm SpanningForestAlgorithm) ([]*WeightedEdge[T, N], N, error) {
+	return g.spanningForest(algorithm, false)
+}
+
+// Computes a maximum spanning forest of an undirected graph using Kruskal's
+// algorithm. Returns the edges of the forest and its total weight.
+func (g *Graph[T, N]) MaximumSpanningForest() ([]*WeightedEdge[T, N], N, error) {
+	return g.MaximumSpanningForestWithAlgorithm(Kruskal)
+}
+
+// Same as MaximumSpanningForest using the given algorithm
+func (g *Graph[T, N]) MaximumSpanningForestWithAlgorithm(algorithm SpanningForestAlgorithm) ([]*WeightedEdge[T, N], N, error) {
+	return g.spanningForest(algorithm, true)
+}
+
+// Edge of the spanning forest computation
+type forestEdge[T comparable, N Number] struct {
+	edge *WeightedEdge[T, N]
+	u, v int
+}
+
+func (g *Graph[T, N]) spanningForest(algorithm SpanningForestAlgorithm, maximum bool) ([]*WeightedEdge[T, N], N, error) {
+	if g.directed {
+		return nil, 0, ErrDirected
+	}
+
+	edges := make([]*forestEdge[T, N], 0, g.NumberOfEdges())
+	for e := range g.Edges() {
+		if e.From == e.To {
+			continue
+		}
+		edges = append(edges, &forestEdge[T, N]{
+			edge: e,
+			u:    g.nodes[e.From],
+			v:    g.nodes[e.To],
+		})
+	}
+
+	var forest []*forestEdge[T, N]
+	switch algorithm {
+	case Kruskal:
+		forest = kruskal(len(g.nodes), edges, maximum)
+	case Prim:
+		forest = prim(len(g.nodes), edges, maximum)
+	case Boruvka:
+		forest = boruvka(len(g.nodes), edges, maximum)
+	default:
+		return nil, 0, fmt.Errorf("unknown spanning forest algorithm: %d", algorithm)
+	}
+
+	var total N
+	res := make([]*WeightedEdge[T, N], len(forest))
+	for i, e := range forest {
+		res[i] = e.edge
+		total += e.edge.Weight
+	}
+	return res, total, nil
+}
+
+// Compares the weights of two edges such that the preferred edge comes first
+func compareForestEdges[T comparable, N Number](a, b *forestEdge[T, N], maximum bool) int {
+	if maximum {
+		return cmp.Compare(b.edge.Weight, a.edge.Weight)
+	}
+	return cmp.Compare(a.edge.Weight, b.edge.Weight)
+}
+
+func kruskal[T comparable, N Number](n int, edges []*forestEdge[T, N], maximum bool) []*forestEdge[T, N] {
+	sorted := slices.Clone(edges)
+	slices.SortStableFunc(sorted, func(a, b *forestEdge[T, N]) int {
+		return compareForestEdges(a, b, maximum)
+	})
+
+	uf := newUnionFind(n)
+	forest := make([]*forestEdge[T, N], 0, max(n-1, 0))
+	for _, e := range sorted {
+		if uf.union(e.u, e.v) {
+			forest = append(forest, e)
+		}
+	}
+	return forest
+}
+
+func prim[T comparable, N Number](n int, edges []*forestEdge[T, N], maximum bool) []*forestEdge[T, N] {
+	adj := make([][]*forestEdge[T, N], n)
+	for _, e := range edges {
+		adj[e.u] = append(adj[e.u], e)
+		adj[e.v] = append(adj[e.v], e)
+	}
+
+	visited := make([]bool, n)
+	best := make([]*forestEdge[T, N], n)
+	forest := make([]*forestEdge[T, N], 0, max(n-1, 0))
+	for root := range n {
+		if visited[root] {
+			continue
+		}
+
+		// Grow a tree from each unvisited node
+		q := newPriorityQueue[int, N](0)
+		q.reverse = maximum
+		q.Append(root, 0)
+		for q.Len() > 0 {
+			u := heap.Pop(q).(*priorityItem[int, N]).node
+			visited[u] = true
+			if best[u] != nil {
+				forest = append(forest, best[u])
+			}
+
+			for _, e := range adj[u] {
+				v := e.u
+				if v == u {
+					v = e.v
+				}
+				if visited[v] || (best[v] != nil && compareForestEdges(best[v], e, maximum) <= 0) {
+					continue
+				}
+				best[v] = e
+
+				pi, ok := q.m[v]
+				if !ok {
+					heap.Push(q, v)
+					pi = q.m[v]
+				}
+				q.update(pi, e.edge.Weight)
+			}
+		}
+	}
+	return forest
+}
+
+func boruvka[T comparable, N Number](n int, edges []*forestEdge[T, N], maximum bool) []*forestEdge[T, N] {
+	// Ties are broken using the edge index to avoid creating cycles
+	index := make(map[*forestEdge[T, N]]int, len(edges))
+	for i, e := range edges {
+		index[e] = i
+	}
+	lighter := func(a, b *forestEdge[T, N]) bool {
+		if b == nil {
+			return true
+		}
+		c := compareForestEdges(a, b, maximum)
+		return c < 0 || (c == 0 && index[a] < index[b])
+	}
+
+	uf := newUnionFind(n)
+	comp := make([]int, n)
+	forest := make([]*forestEdge[T, N], 0, max(n-1, 0))
+	edges = slices.Clone(edges)
+	for {
+		// Number the live components from 0 to k-1
+		k := 0
+		for i := range comp {
+			if uf.find(i) == i {
+				comp[i] = k
+				k++
+			}
+		}
+		for i := range comp {
+			comp[i] = comp[uf.find(i)]
+		}
+
+		// Drop the edges which no longer connect different components
+		live := edges[:0]
+		for _, e := range edges {
+			if comp[e.u] != comp[e.v] {
+				live = append(live, e)
+			}
+		}
+		edges = live
+		if len(edges) == 0 {
+			return forest
+		}
+
+		// Find the lightest edge leaving each component in parallel
+		workers := numWorkers(0, len(edges))
+		chunk := (len(edges) + workers - 1) / workers
+		cheapest := make([][]*forestEdge[T, N], workers)
+		var wg sync.WaitGroup
+		for w := range workers {
+			lo, hi := min(w*chunk, len(edges)), min((w+1)*chunk, len(edges))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				local := make([]*forestEdge[T, N], k)
+				for _, e := range edges[lo:hi] {
+					cu, cv := comp[e.u], comp[e.v]
+					if lighter(e, local[cu]) {
+						local[cu] = e
+					}
+					if lighter(e, local[cv]) {
+						local[cv] = e
+					}
+				}
+				cheapest[w] = local
+			}()
+		}
+		wg.Wait()
+
+		for c := range k {
+			var e *forestEdge[T, N]
+			for _, local := range cheapest {
+				if local[c] != nil && lighter(local[c], e) {
+					e = local[c]
+				}
+			}
+			if e != nil && uf.union(e.u, e.v) {
+				forest = append(forest, e)
+			}
+		}
+	}
+}
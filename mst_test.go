@@ -0,0 +1,145 @@
+package edsger
+
+import (
+	"errors"
+	"testing"
+)
+
+var spanningForestAlgorithms = map[string]SpanningForestAlgorithm{
+	"Kruskal": Kruskal,
+	"Prim":    Prim,
+	"Boruvka": Boruvka,
+}
+
+func validateSpanningForest[T comparable, N Number](t *testing.T, g *Graph[T, N], forest []*WeightedEdge[T, N], total N) {
+	uf := newUnionFind(g.NumberOfNodes())
+	var sum N
+	for _, e := range forest {
+		if w, ok := g.GetEdge(e.From, e.To); !ok || w != e.Weight {
+			t.Fatal("Invalid edge:", e)
+		}
+		if !uf.union(g.nodes[e.From], g.nodes[e.To]) {
+			t.Fatal("Forest contains a cycle")
+		}
+		sum += e.Weight
+	}
+	if sum != total {
+		t.Fatal("Invalid total weight:", sum, total)
+	}
+
+	// The forest must span all connected components
+	for e := range g.Edges() {
+		if uf.find(g.nodes[e.From]) != uf.find(g.nodes[e.To]) {
+			t.Fatal("Forest does not span edge", e)
+		}
+	}
+}
+
+func TestMinimumSpanningForestWikipedia(t *testing.T) {
+	g := WikipediaGraph()
+	for name, algorithm := range spanningForestAlgorithms {
+		forest, total, err := g.MinimumSpanningForestWithAlgorithm(algorithm)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if total != 33 || len(forest) != 5 {
+			t.Fatalf("%s: invalid forest: %v %d", name, forest, total)
+		}
+		validateSpanningForest(t, g, forest, total)
+
+		forest, total, err = g.MaximumSpanningForestWithAlgorithm(algorithm)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if total != 58 || len(forest) != 5 {
+			t.Fatalf("%s: invalid forest: %v %d", name, forest, total)
+		}
+		validateSpanningForest(t, g, forest, total)
+	}
+}
+
+func TestSpanningForestRandomGraphs(t *testing.T) {
+	for seed := range int64(10) {
+		g := RandomGraph(false, 50, 80, 1, 10, seed)
+		minimum, _, _ := g.MinimumSpanningForest()
+		maximum, _, _ := g.MaximumSpanningForest()
+
+		for name, algorithm := range spanningForestAlgorithms {
+			forest, total, err := g.MinimumSpanningForestWithAlgorithm(algorithm)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			validateSpanningForest(t, g, forest, total)
+			if len(forest) != len(minimum) || total != sumWeights(minimum) {
+				t.Fatalf("%s: invalid minimum spanning forest weight: %d", name, total)
+			}
+
+			forest, total, err = g.MaximumSpanningForestWithAlgorithm(algorithm)
+			if err != nil {
+				t.Fatal("Unexpected error:", err)
+			}
+			validateSpanningForest(t, g, forest, total)
+			if len(forest) != len(maximum) || total != sumWeights(maximum) {
+				t.Fatalf("%s: invalid maximum spanning forest weight: %d", name, total)
+			}
+		}
+	}
+}
+
+func sumWeights[T comparable, N Number](edges []*WeightedEdge[T, N]) N {
+	var sum N
+	for _, e := range edges {
+		sum += e.Weight
+	}
+	return sum
+}
+
+func TestSpanningForestDirected(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	if _, _, err := g.MinimumSpanningForest(); !errors.Is(err, ErrDirected) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestSpanningForestEmptyGraph(t *testing.T) {
+	g := NewUndirectedGraph[int, int]()
+	for name, algorithm := range spanningForestAlgorithms {
+		forest, total, err := g.MinimumSpanningForestWithAlgorithm(algorithm)
+		if err != nil || len(forest) != 0 || total != 0 {
+			t.Fatalf("%s: invalid minimum spanning forest of an empty graph: %v %d %v", name, forest, total, err)
+		}
+		forest, total, err = g.MaximumSpanningForestWithAlgorithm(algorithm)
+		if err != nil || len(forest) != 0 || total != 0 {
+			t.Fatalf("%s: invalid maximum spanning forest of an empty graph: %v %d %v", name, forest, total, err)
+		}
+	}
+}
+
+func TestSpanningForestSignedWeights(t *testing.T) {
+	// Subtracting weights from the largest one overflows int8
+	g := NewUndirectedGraph[int, int8]()
+	for i := range 3 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 100)
+	g.AddEdge(1, 2, -100)
+	g.AddEdge(0, 2, 0)
+
+	for name, algorithm := range spanningForestAlgorithms {
+		forest, total, err := g.MaximumSpanningForestWithAlgorithm(algorithm)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if len(forest) != 2 || total != 100 {
+			t.Fatalf("%s: invalid maximum spanning forest: %v %d", name, forest, total)
+		}
+
+		forest, total, err = g.MinimumSpanningForestWithAlgorithm(algorithm)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if len(forest) != 2 || total != -100 {
+			t.Fatalf("%s: invalid minimum spanning forest: %v %d", name, forest, total)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package edsger
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+)
+
+// Arc of a residual flow network. Each arc is paired with a reverse arc
+// having a capacity of zero. Costs are stored as float64 such that reverse
+// arcs can have a negative cost for any edge weight type. Costs which cannot
+// be handled exactly are rejected by checkCosts.
+type flowArc[N Number] struct {
+	from     int
+	to       int
+	capacity N
+	residual N
+	cost     float64
+	rev      *flowArc[N]
+}
+
+func (a *flowArc[N]) flow() N {
+	return a.capacity - a.residual
+}
+
+// Pushes the given amount of flow along the arc
+func (a *flowArc[N]) push(f N) {
+	a.residual -= f
+	a.rev.residual += f
+}
+
+// Residual flow network with nodes indexed by integers, used by the flow
+// and disjoint path algorithms
+type flowNetwork[N Number] struct {
+	arcs [][]*flowArc[N]
+	// Sum of the absolute costs of the arcs
+	costs float64
+	// Whether the cost of an arc is not exactly represented as float64
+	inexact bool
+}
+
+// Bound on the sum of the absolute integer costs, such that all potentials
+// and reduced costs are integers computed exactly using float64
+const maxExactCosts = 1 << 50
+
+func newFlowNetwork[N Number](n int) *flowNetwork[N] {
+	return &flowNetwork[N]{
+		arcs: make([][]*flowArc[N], n),
+	}
+}
+
+func (fn *flowNetwork[N]) addArc(from, to int, capacity, cost N) *flowArc[N] {
+	c := float64(cost)
+	if N(c) != cost {
+		fn.inexact = true
+	}
+	fn.costs += math.Abs(c)
+
+	a := &flowArc[N]{from: from, to: to, capacity: capacity, residual: capacity, cost: c}
+	r := &flowArc[N]{from: to, to: from, cost: -c}
+	a.rev, r.rev = r, a
+	fn.arcs[from] = append(fn.arcs[from], a)
+	fn.arcs[to] = append(fn.arcs[to], r)
+	return a
+}
+
+// Returns an error if the costs of the arcs cannot be handled exactly
+func (fn *flowNetwork[N]) checkCosts() error {
+	if fn.inexact {
+		return ErrInexactCost
+	}
+	if integer := !math.IsInf(float64(MaxValue[N]()), 1); integer && fn.costs > maxExactCosts {
+		return fmt.Errorf("%w: absolute costs sum up to %v", ErrInexactCost, fn.costs)
+	}
+	return nil
+}
+
+// Computes initial node potentials using the Bellman-Ford algorithm such that
+// all reduced costs are non-negative
+func (fn *flowNetwork[N]) initialPotentials() ([]float64, error) {
+	pi := make([]float64, len(fn.arcs))
+	for i := range len(fn.arcs) {
+		updated := false
+		for _, arcs := range fn.arcs {
+			for _, a := range arcs {
+				if a.residual > 0 && pi[a.from]+a.cost < pi[a.to] {
+					pi[a.to] = pi[a.from] + a.cost
+					updated = true
+				}
+			}
+		}
+		if !updated {
+			return pi, nil
+		} else if i == len(fn.arcs)-1 {
+			return nil, ErrNegativeCycle
+		}
+	}
+	return pi, nil
+}
+
+// Dijkstra's shortest path algorithm on the residual network using reduced
+// costs. Returns the arc used to reach each node, or nil if not reachable.
+func (fn *flowNetwork[N]) shortestPathArcs(s int, pi []float64) []*flowArc[N] {
+	dist := make([]float64, len(fn.arcs))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[s] = 0
+	prev := make([]*flowArc[N], len(fn.arcs))
+	done := make([]bool, len(fn.arcs))
+
+	q := newPriorityQueue[int, float64](0)
+	q.Append(s, 0)
+	for q.Len() > 0 {
+		u := heap.Pop(q).(*priorityItem[int, float64]).node
+		done[u] = true
+		for _, a := range fn.arcs[u] {
+			if a.residual <= 0 || done[a.to] {
+				continue
+			}
+			// Clamp rounding errors of floating-point potentials
+			alt := dist[u] + max(0, a.cost+pi[u]-pi[a.to])
+			if alt < dist[a.to] {
+				dist[a.to] = alt
+				prev[a.to] = a
+
+				item, ok := q.m[a.to]
+				if !ok {
+					heap.Push(q, a.to)
+					item = q.m[a.to]
+				}
+				q.update(item, alt)
+			}
+		}
+	}
+
+	// Update the potentials of reachable nodes. Unreachable nodes remain
+	// unreachable in subsequent iterations.
+	for v, d := range dist {
+		if !math.IsInf(d, 1) {
+			pi[v] += d
+		}
+	}
+	return prev
+}
+
+// Successive shortest paths algorithm for minimum-cost flows. Sends at most
+// limit units of flow from s to t and returns the amount of flow sent.
+func (fn *flowNetwork[N]) successiveShortestPaths(s, t int, limit N) (N, error) {
+	if err := fn.checkCosts(); err != nil {
+		return 0, err
+	}
+	pi, err := fn.initialPotentials()
+	if err != nil {
+		return 0, err
+	}
+
+	var flow N
+	for flow < limit {
+		prev := fn.shortestPathArcs(s, pi)
+		if prev[t] == nil {
+			break
+		}
+
+		f := limit - flow
+		for v := t; v != s; v = prev[v].from {
+			f = min(f, prev[v].residual)
+		}
+		for v := t; v != s; v = prev[v].from {
+			prev[v].push(f)
+		}
+		flow += f
+	}
+	return flow, nil
+}
+
+// Decomposes one unit of flow from s to t into a path and removes it from
+// the network. Cycles encountered along the way are removed from the path.
+func (fn *flowNetwork[N]) extractPath(s, t int) []int {
+	path := []int{s}
+	pos := map[int]int{s: 0}
+	for u := s; u != t; {
+		var next *flowArc[N]
+		for _, a := range fn.arcs[u] {
+			if a.capacity > 0 && a.flow() > 0 {
+				next = a
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		next.residual++
+		next.rev.residual--
+
+		u = next.to
+		if i, ok := pos[u]; ok {
+			for _, v := range path[i+1:] {
+				delete(pos, v)
+			}
+			path = path[:i+1]
+		} else {
+			pos[u] = len(path)
+			path = append(path, u)
+		}
+	}
+	return path
+}
@@ -0,0 +1,270 @@
+package edsger
+
+import (
+	"fmt"
+	"math"
+)
+
+// Options of the algorithms based on power iteration
+type PowerIterationOptions struct {
+	// Convergence tolerance. Uses the default of the algorithm if not set.
+	Tolerance float64
+	// Maximum number of iterations. Uses 100 if not set.
+	MaxIterations int
+	// Uses the edge weights instead of considering all edges equal
+	Weighted bool
+	// Maximum number of parallel workers. Uses GOMAXPROCS if not set.
+	Workers int
+}
+
+func (o PowerIterationOptions) withDefaults(tolerance float64) PowerIterationOptions {
+	if o.Tolerance <= 0 {
+		o.Tolerance = tolerance
+	}
+	if o.MaxIterations <= 0 {
+		o.MaxIterations = 100
+	}
+	return o
+}
+
+// Options of the PageRank computation
+type PageRankOptions struct {
+	PowerIterationOptions
+	// Probability of following an edge instead of restarting. Uses 0.85 if
+	// not set.
+	Damping float64
+}
+
+// Returns the adjacency of the graph using the node indices of NodesList and
+// either the edge weights or unit weights
+func (g *Graph[T, N]) floatAdjacency(weighted bool) ([][]indexedEdge[float64], error) {
+	if weighted {
+		if err := g.checkNonNegativeWeights(); err != nil {
+			return nil, err
+		}
+	}
+
+	adj := make([][]indexedEdge[float64], len(g.nodes))
+	for i, edges := range g.indexedAdjacency() {
+		adj[i] = make([]indexedEdge[float64], len(edges))
+		for j, e := range edges {
+			adj[i][j] = indexedEdge[float64]{to: e.to, weight: 1}
+			if weighted {
+				adj[i][j].weight = float64(e.weight)
+			}
+		}
+	}
+	return adj, nil
+}
+
+// Returns the adjacency with all edges reversed
+func transpose[N Number](adj [][]indexedEdge[N]) [][]indexedEdge[N] {
+	res := make([][]indexedEdge[N], len(adj))
+	for u, edges := range adj {
+		for _, e := range edges {
+			res[e.to] = append(res[e.to], indexedEdge[N]{to: u, weight: e.weight})
+		}
+	}
+	return res
+}
+
+// Returns the scores indexed by the node indices as a map
+func (g *Graph[T, N]) indexedScores(x []float64) map[T]float64 {
+	res := make(map[T]float64, len(x))
+	for i, node := range g.NodesList() {
+		res[node] = x[i]
+	}
+	return res
+}
+
+// Computes the PageRank of each node. The rank of dangling nodes, i.e. nodes
+// without outgoing edges, is distributed uniformly over all nodes. Returns
+// ErrNotConverged if the ranks do not converge within the maximum number of
+// iterations. The default tolerance is 1e-6.
+func (g *Graph[T, N]) PageRank(opts PageRankOptions) (map[T]float64, error) {
+	n := len(g.nodes)
+	p := make([]float64, n)
+	for i := range p {
+		p[i] = 1 / float64(n)
+	}
+	return g.pageRank(p, opts)
+}
+
+// Computes the personalized PageRank of each node, where random walks restart
+// at a node following the given distribution instead of uniformly. Nodes not
+// in the distribution have a weight of zero and the weights are normalized to
+// sum up to one. The rank of dangling nodes is distributed following the same
+// distribution.
+func (g *Graph[T, N]) PersonalizedPageRank(personalization map[T]float64, opts PageRankOptions) (map[T]float64, error) {
+	p, err := g.distribution(personalization)
+	if err != nil {
+		return nil, err
+	}
+	return g.pageRank(p, opts)
+}
+
+// Returns the normalized distribution indexed by the node indices
+func (g *Graph[T, N]) distribution(weights map[T]float64) ([]float64, error) {
+	p := make([]float64, len(g.nodes))
+	var sum float64
+	for node, w := range weights {
+		if err := g.checkNode(node); err != nil {
+			return nil, err
+		}
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w: weight %v for %v", ErrInvalidWeights, w, node)
+		}
+		p[g.nodes[node]] = w
+		sum += w
+	}
+	if sum == 0 {
+		return nil, fmt.Errorf("%w: weights sum up to zero", ErrInvalidWeights)
+	}
+	for i := range p {
+		p[i] /= sum
+	}
+	return p, nil
+}
+
+func (g *Graph[T, N]) pageRank(p []float64, opts PageRankOptions) (map[T]float64, error) {
+	n := len(g.nodes)
+	if n == 0 {
+		return map[T]float64{}, nil
+	}
+	damping := opts.Damping
+	if damping == 0 {
+		damping = 0.85
+	}
+	po := opts.PowerIterationOptions.withDefaults(1e-6)
+
+	adj, err := g.floatAdjacency(po.Weighted)
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalize the outgoing weights into transition probabilities
+	var dangling []int
+	for u, edges := range adj {
+		var sum float64
+		for _, e := range edges {
+			sum += e.weight
+		}
+		if sum == 0 {
+			dangling = append(dangling, u)
+			continue
+		}
+		for i := range edges {
+			edges[i].weight /= sum
+		}
+	}
+	in := transpose(adj)
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	for range po.MaxIterations {
+		var danglingSum float64
+		for _, u := range dangling {
+			danglingSum += x[u]
+		}
+		restart := damping*danglingSum + 1 - damping
+
+		next := make([]float64, n)
+		parallelRange(n, po.Workers, func(lo, hi int) {
+			for v := lo; v < hi; v++ {
+				var s float64
+				for _, e := range in[v] {
+					s += x[e.to] * e.weight
+				}
+				next[v] = damping*s + restart*p[v]
+			}
+		})
+
+		diff := l1Distance(next, x)
+		x = next
+		if diff < float64(n)*po.Tolerance {
+			return g.indexedScores(x), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: PageRank after %d iterations", ErrNotConverged, po.MaxIterations)
+}
+
+// Computes the hub and authority scores of each node using the HITS
+// algorithm. Good hubs point to many good authorities, and good authorities
+// are pointed to by many good hubs. Scores are normalized to sum up to one.
+// Returns ErrNotConverged if the scores do not converge within the maximum
+// number of iterations. The default tolerance is 1e-8.
+func (g *Graph[T, N]) HITS(opts PowerIterationOptions) (hubs, authorities map[T]float64, err error) {
+	n := len(g.nodes)
+	if n == 0 {
+		return map[T]float64{}, map[T]float64{}, nil
+	}
+	opts = opts.withDefaults(1e-8)
+
+	out, err := g.floatAdjacency(opts.Weighted)
+	if err != nil {
+		return nil, nil, err
+	}
+	in := transpose(out)
+
+	h := make([]float64, n)
+	for i := range h {
+		h[i] = 1 / float64(n)
+	}
+	for range opts.MaxIterations {
+		a := make([]float64, n)
+		parallelRange(n, opts.Workers, func(lo, hi int) {
+			for v := lo; v < hi; v++ {
+				for _, e := range in[v] {
+					a[v] += h[e.to] * e.weight
+				}
+			}
+		})
+		next := make([]float64, n)
+		parallelRange(n, opts.Workers, func(lo, hi int) {
+			for u := lo; u < hi; u++ {
+				for _, e := range out[u] {
+					next[u] += a[e.to] * e.weight
+				}
+			}
+		})
+		scaleToMax(a)
+		scaleToMax(next)
+
+		diff := l1Distance(next, h)
+		h = next
+		if diff < opts.Tolerance {
+			scaleToSum(h)
+			scaleToSum(a)
+			return g.indexedScores(h), g.indexedScores(a), nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: HITS after %d iterations", ErrNotConverged, opts.MaxIterations)
+}
+
+// Scales the values such that their maximum is one
+func scaleToMax(x []float64) {
+	var m float64
+	for _, v := range x {
+		m = max(m, v)
+	}
+	if m > 0 {
+		for i := range x {
+			x[i] /= m
+		}
+	}
+}
+
+// Scales the values such that they sum up to one
+func scaleToSum(x []float64) {
+	var s float64
+	for _, v := range x {
+		s += v
+	}
+	if s > 0 {
+		for i := range x {
+			x[i] /= s
+		}
+	}
+}
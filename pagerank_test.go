@@ -0,0 +1,137 @@
+package edsger
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPageRankCycle(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 3 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 0, 1)
+
+	pr, err := g.PageRank(PageRankOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, pr, map[int]float64{0: 1.0 / 3, 1: 1.0 / 3, 2: 1.0 / 3})
+}
+
+func TestPageRankDangling(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	g.AddNode(0)
+	g.AddNode(1)
+	g.AddEdge(0, 1, 1)
+
+	pr, err := g.PageRank(PageRankOptions{PowerIterationOptions: PowerIterationOptions{Tolerance: 1e-12}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, pr, map[int]float64{0: 0.5 / 1.425, 1: 1 - 0.5/1.425})
+}
+
+func TestPageRankWeighted(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 3 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 3)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 0, 1)
+	g.AddEdge(2, 0, 1)
+
+	pr, err := g.PageRank(PageRankOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(pr[1]-pr[2]) > 1e-9 {
+		t.Fatalf("Expected equal ranks without weights: %v", pr)
+	}
+
+	pr, err = g.PageRank(PageRankOptions{PowerIterationOptions: PowerIterationOptions{Weighted: true, Workers: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr[1] <= pr[2] {
+		t.Fatalf("Expected a higher rank for the heavier edge: %v", pr)
+	}
+	if sum := pr[0] + pr[1] + pr[2]; math.Abs(sum-1) > 1e-9 {
+		t.Fatalf("Ranks sum up to %v", sum)
+	}
+}
+
+func TestPageRankKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	pr, err := g.PageRank(PageRankOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sum float64
+	best := 0
+	for node, r := range pr {
+		sum += r
+		if r > pr[best] {
+			best = node
+		}
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Fatalf("Ranks sum up to %v", sum)
+	}
+	if best != 33 {
+		t.Fatalf("Expected node 33 to have the highest rank, got %d", best)
+	}
+}
+
+func TestPersonalizedPageRank(t *testing.T) {
+	g := PathGraph(2)
+
+	pr, err := g.PersonalizedPageRank(map[int]float64{0: 2}, PageRankOptions{PowerIterationOptions: PowerIterationOptions{Tolerance: 1e-12, MaxIterations: 500}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScores(t, pr, map[int]float64{0: 0.15 / 0.2775, 1: 0.85 * 0.15 / 0.2775})
+
+	if _, err := g.PersonalizedPageRank(map[int]float64{0: 0}, PageRankOptions{}); !errors.Is(err, ErrInvalidWeights) {
+		t.Fatalf("Expected ErrInvalidWeights, got %v", err)
+	}
+	if _, err := g.PersonalizedPageRank(map[int]float64{42: 1}, PageRankOptions{}); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatalf("Expected ErrNodeNotFound, got %v", err)
+	}
+}
+
+func TestPageRankNotConverged(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	_, err := g.PageRank(PageRankOptions{PowerIterationOptions: PowerIterationOptions{MaxIterations: 2}})
+	if !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("Expected ErrNotConverged, got %v", err)
+	}
+}
+
+func TestHITS(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 3 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 2, 1)
+
+	hubs, authorities, err := g.HITS(PowerIterationOptions{Tolerance: 1e-12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	phi := (1 + math.Sqrt(5)) / 2
+	assertScores(t, hubs, map[int]float64{0: 1 / phi, 1: 1 / (phi * phi), 2: 0})
+	assertScores(t, authorities, map[int]float64{0: 0, 1: 1 / (phi * phi), 2: 1 / phi})
+
+	if _, _, err := g.HITS(PowerIterationOptions{MaxIterations: 1}); !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("Expected ErrNotConverged, got %v", err)
+	}
+}
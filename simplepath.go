@@ -6,9 +6,16 @@ import (
 )
 
 func (g *Graph[T, N]) HasSimplePath(source, dest T) bool {
-	g.validatePathNodes(source, dest)
+	return must(g.TryHasSimplePath(source, dest))
+}
+
+// Same as HasSimplePath but returns an error instead of panicking
+func (g *Graph[T, N]) TryHasSimplePath(source, dest T) (bool, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return false, err
+	}
 	visited := make(map[T]bool)
-	return g.hasSimplePath(source, dest, visited)
+	return g.hasSimplePath(source, dest, visited), nil
 }
 
 func (g *Graph[T, N]) hasSimplePath(source, dest T, visited map[T]bool) bool {
@@ -28,9 +35,17 @@ func (g *Graph[T, N]) hasSimplePath(source, dest T, visited map[T]bool) bool {
 }
 
 func (g *Graph[T, N]) SimplePath(source, dest T) ([]T, N) {
-	g.validatePathNodes(source, dest)
+	return must2(g.TrySimplePath(source, dest))
+}
+
+// Same as SimplePath but returns an error instead of panicking
+func (g *Graph[T, N]) TrySimplePath(source, dest T) ([]T, N, error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, 0, err
+	}
 	visited := make(map[T]bool)
-	return g.simplePath(source, dest, visited, []T{source}, 0)
+	path, total := g.simplePath(source, dest, visited, []T{source}, 0)
+	return path, total, nil
 }
 
 func (g *Graph[T, N]) simplePath(source, dest T, visited map[T]bool, currentPath []T, totalWeight N) ([]T, N) {
@@ -76,7 +91,14 @@ type SimplePathIterator[T comparable, N Number] struct {
 }
 
 func (g *Graph[T, N]) AllSimplePaths(source, dest T) *SimplePathIterator[T, N] {
-	g.validatePathNodes(source, dest)
+	return must(g.TryAllSimplePaths(source, dest))
+}
+
+// Same as AllSimplePaths but returns an error instead of panicking
+func (g *Graph[T, N]) TryAllSimplePaths(source, dest T) (*SimplePathIterator[T, N], error) {
+	if err := g.checkPathNodes(source, dest); err != nil {
+		return nil, err
+	}
 	return &SimplePathIterator[T, N]{
 		g:            g,
 		CutoffWeight: MaxValue[N](),
@@ -91,16 +113,24 @@ func (g *Graph[T, N]) AllSimplePaths(source, dest T) *SimplePathIterator[T, N] {
 				edges: g.edges[source],
 			},
 		},
-	}
+	}, nil
 }
 
 func (g *Graph[T, N]) AllSimplePathsWithHeuristic(source, dest T, heuristic func(i, j *NodeWeight[T, N]) int) *SimplePathIterator[T, N] {
-	it := g.AllSimplePaths(source, dest)
+	return must(g.TryAllSimplePathsWithHeuristic(source, dest, heuristic))
+}
+
+// Same as AllSimplePathsWithHeuristic but returns an error instead of panicking
+func (g *Graph[T, N]) TryAllSimplePathsWithHeuristic(source, dest T, heuristic func(i, j *NodeWeight[T, N]) int) (*SimplePathIterator[T, N], error) {
+	it, err := g.TryAllSimplePaths(source, dest)
+	if err != nil {
+		return nil, err
+	}
 	it.heuristic = heuristic
 	for _, e := range it.stack {
 		e.edges = it.applyHeuristic(e.edges)
 	}
-	return it
+	return it, nil
 }
 
 func (it *SimplePathIterator[T, N]) applyHeuristic(edges []*NodeWeight[T, N]) []*NodeWeight[T, N] {
@@ -1,6 +1,9 @@
 package edsger
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestHasSimplePath(t *testing.T) {
 	{
@@ -93,3 +96,16 @@ func TestAllSimplePathsWithHeuristic(t *testing.T) {
 		t.Log(path, weight)
 	}
 }
+
+func TestTrySimplePath(t *testing.T) {
+	g := WikipediaGraph()
+	if _, err := g.TryHasSimplePath(1, 42); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, _, err := g.TrySimplePath(42, 1); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+	if _, err := g.TryAllSimplePaths(1, 42); !errors.Is(err, ErrNodeNotFound) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
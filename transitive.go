@@ -0,0 +1,143 @@
+package edsger
+
+import (
+	"maps"
+	"math/bits"
+	"slices"
+)
+
+// Compact reachability index of a DAG. Descendants of each node are stored
+// as a bitset indexed by node index.
+type Reachability[T comparable] struct {
+	nodes       []T
+	index       map[T]int
+	descendants [][]uint64
+}
+
+// Computes the reachability index of a DAG by processing nodes in reverse
+// topological order
+func (g *Graph[T, N]) ReachabilityIndex() (*Reachability[T], error) {
+	order, err := g.TopologicalOrdering()
+	if err != nil {
+		return nil, err
+	}
+
+	words := (len(g.nodes) + 63) / 64
+	r := &Reachability[T]{
+		nodes:       g.NodesList(),
+		index:       maps.Clone(g.nodes),
+		descendants: make([][]uint64, len(g.nodes)),
+	}
+	for _, u := range slices.Backward(order) {
+		set := make([]uint64, words)
+		for _, e := range g.edges[u] {
+			v := g.nodes[e.Node]
+			set[v/64] |= 1 << (v % 64)
+			for i, w := range r.descendants[v] {
+				set[i] |= w
+			}
+		}
+		r.descendants[g.nodes[u]] = set
+	}
+	return r, nil
+}
+
+// Returns true if dest can be reached from source using at least one edge
+func (r *Reachability[T]) Reachable(source, dest T) bool {
+	i, ok := r.index[source]
+	if !ok {
+		return false
+	}
+	j, ok := r.index[dest]
+	if !ok {
+		return false
+	}
+	return r.descendants[i][j/64]&(1<<(j%64)) != 0
+}
+
+// Returns all nodes reachable from node
+func (r *Reachability[T]) Descendants(node T) []T {
+	i, ok := r.index[node]
+	if !ok {
+		return nil
+	}
+
+	var res []T
+	for w, set := range r.descendants[i] {
+		for set != 0 {
+			b := bits.TrailingZeros64(set)
+			res = append(res, r.nodes[w*64+b])
+			set &= set - 1
+		}
+	}
+	return res
+}
+
+// Returns all nodes from which node can be reached
+func (r *Reachability[T]) Ancestors(node T) []T {
+	j, ok := r.index[node]
+	if !ok {
+		return nil
+	}
+
+	var res []T
+	for i, set := range r.descendants {
+		if set[j/64]&(1<<(j%64)) != 0 {
+			res = append(res, r.nodes[i])
+		}
+	}
+	return res
+}
+
+// Returns the transitive closure of a DAG, i.e. a new graph with an edge
+// between two nodes if the second one is reachable from the first one. Edges
+// of the original graph keep their weight and added edges have a zero weight.
+func (g *Graph[T, N]) TransitiveClosure() (*Graph[T, N], error) {
+	r, err := g.ReachabilityIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	res := NewDirectedGraph[T, N]()
+	for _, n := range r.nodes {
+		res.AddNode(n)
+	}
+	for _, u := range r.nodes {
+		for _, v := range r.Descendants(u) {
+			w, _ := g.getEdge(u, v)
+			res.addEdge(u, v, w)
+		}
+	}
+	return res, nil
+}
+
+// Returns the transitive reduction of a DAG, i.e. a new graph with the
+// fewest edges having the same reachability as the original graph. An edge
+// is removed if its destination can also be reached through another
+// successor of its source.
+func (g *Graph[T, N]) TransitiveReduction() (*Graph[T, N], error) {
+	r, err := g.ReachabilityIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	res := NewDirectedGraph[T, N]()
+	for _, n := range r.nodes {
+		res.AddNode(n)
+	}
+	for u, edges := range g.edges {
+		for _, e := range edges {
+			redundant := false
+			for _, other := range edges {
+				if other.Node != e.Node && r.Reachable(other.Node, e.Node) {
+					redundant = true
+					break
+				}
+			}
+			if !redundant {
+				res.addEdge(u, e.Node, e.Weight)
+			}
+		}
+	}
+	return res, nil
+}
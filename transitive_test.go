@@ -0,0 +1,101 @@
+package edsger
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestReachabilityIndex(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	r, err := g.ReachabilityIndex()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	for u := range g.Nodes() {
+		for v := range g.Nodes() {
+			expected := u != v && g.HasSimplePath(u, v)
+			if r.Reachable(u, v) != expected {
+				t.Fatal("Invalid reachability between", u, v)
+			}
+		}
+	}
+
+	descendants := r.Descendants(7)
+	slices.Sort(descendants)
+	if !slices.Equal(descendants, []int{2, 8, 9, 10, 11}) {
+		t.Fatal("Invalid descendants:", descendants)
+	}
+
+	ancestors := r.Ancestors(9)
+	slices.Sort(ancestors)
+	if !slices.Equal(ancestors, []int{3, 5, 7, 8, 11}) {
+		t.Fatal("Invalid ancestors:", ancestors)
+	}
+
+	g.AddEdge(10, 5, 0)
+	if _, err := g.ReachabilityIndex(); !errors.Is(err, ErrCycle) {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	g := WikipediaDirectedAcyclicGraph()
+	closure, err := g.TransitiveClosure()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	for u := range g.Nodes() {
+		for v := range g.Nodes() {
+			expected := u != v && g.HasSimplePath(u, v)
+			if closure.HasEdge(u, v) != expected {
+				t.Fatal("Invalid edge between", u, v)
+			}
+		}
+	}
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(0, 3, 1)
+	g.AddEdge(1, 3, 1)
+
+	reduction, err := g.TransitiveReduction()
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if reduction.NumberOfEdges() != 3 || !reduction.HasEdge(0, 1) || !reduction.HasEdge(1, 2) || !reduction.HasEdge(2, 3) {
+		t.Fatal("Invalid transitive reduction")
+	}
+
+	// The reduction of the closure is the reduction of the graph
+	for seed := range int64(5) {
+		g := RandomGraph(true, 20, 60, 1, 1, seed)
+		for _, e := range slices.Collect(g.Edges()) {
+			if e.From > e.To {
+				g.RemoveEdge(e.From, e.To)
+			}
+		}
+
+		reduction, _ := g.TransitiveReduction()
+		closure, _ := g.TransitiveClosure()
+		closureReduction, _ := closure.TransitiveReduction()
+		if reduction.NumberOfEdges() != closureReduction.NumberOfEdges() {
+			t.Fatal("Invalid transitive reduction")
+		}
+		for e := range reduction.Edges() {
+			if !closureReduction.HasEdge(e.From, e.To) {
+				t.Fatal("Invalid transitive reduction")
+			}
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package edsger
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Neighbors of each node using node indices, without self-loops
+func (g *Graph[T, N]) simpleAdjacency() [][]indexedEdge[N] {
+	adj := g.indexedAdjacency()
+	for u, edges := range adj {
+		adj[u] = slices.DeleteFunc(edges, func(e indexedEdge[N]) bool {
+			return e.to == u
+		})
+	}
+	return adj
+}
+
+// Calls fn for each triangle (u, v, w) of the undirected adjacency with the
+// weights of its edges, using at most the given number of parallel workers.
+// Edges are oriented from lower to higher degree such that each triangle is
+// found exactly once and the work per node is bounded by the square root of
+// the number of edges.
+func eachTriangle[N Number](adj [][]indexedEdge[N], workers int, fn func(worker, u, v, w int, wuv, wuw, wvw N)) {
+	n := len(adj)
+	rank := make([]int, n)
+	order := identity(n)
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Or(cmp.Compare(len(adj[a]), len(adj[b])), cmp.Compare(a, b))
+	})
+	for r, u := range order {
+		rank[u] = r
+	}
+
+	forward := make([][]indexedEdge[N], n)
+	for u, edges := range adj {
+		for _, e := range edges {
+			if rank[e.to] > rank[u] {
+				forward[u] = append(forward[u], e)
+			}
+		}
+	}
+
+	workers = numWorkers(workers, n)
+	marks := make([][]int, workers)
+	weights := make([][]N, workers)
+	for i := range marks {
+		marks[i] = make([]int, n)
+		weights[i] = make([]N, n)
+	}
+	parallelEach(order, workers, func(worker, u int) {
+		mark, weight := marks[worker], weights[worker]
+		for _, e := range forward[u] {
+			mark[e.to] = u + 1
+			weight[e.to] = e.weight
+		}
+		for _, e := range forward[u] {
+			v := e.to
+			for _, f := range forward[v] {
+				if w := f.to; mark[w] == u+1 {
+					fn(worker, u, v, w, e.weight, weight[w], f.weight)
+				}
+			}
+		}
+	})
+}
+
+// Returns the number of triangles each node of an undirected graph belongs to
+func (g *Graph[T, N]) Triangles() (map[T]int, error) {
+	if g.directed {
+		return nil, ErrDirected
+	}
+
+	n := len(g.nodes)
+	workers := numWorkers(0, n)
+	counts := make([][]int, workers)
+	for i := range counts {
+		counts[i] = make([]int, n)
+	}
+	eachTriangle(g.simpleAdjacency(), workers, func(worker, u, v, w int, _, _, _ N) {
+		counts[worker][u]++
+		counts[worker][v]++
+		counts[worker][w]++
+	})
+
+	res := make(map[T]int, n)
+	for i, node := range g.NodesList() {
+		for _, c := range counts {
+			res[node] += c[i]
+		}
+	}
+	return res, nil
+}
+
+// Returns the local clustering coefficient of each node of an undirected
+// graph, i.e. the fraction of pairs of neighbors of the node which are
+// connected. With weights, each triangle contributes the geometric mean of
+// its edge weights normalized by the largest weight of the graph, following
+// Onnela et al. Weights must be non-negative.
+func (g *Graph[T, N]) Clustering(weighted bool) (map[T]float64, error) {
+	if g.directed {
+		return nil, ErrDirected
+	}
+	if weighted {
+		if err := g.checkNonNegativeWeights(); err != nil {
+			return nil, err
+		}
+	}
+
+	adj := g.simpleAdjacency()
+	var maxWeight float64
+	for _, edges := range adj {
+		for _, e := range edges {
+			maxWeight = max(maxWeight, float64(e.weight))
+		}
+	}
+
+	n := len(g.nodes)
+	workers := numWorkers(0, n)
+	sums := make([][]float64, workers)
+	for i := range sums {
+		sums[i] = make([]float64, n)
+	}
+	eachTriangle(adj, workers, func(worker, u, v, w int, wuv, wuw, wvw N) {
+		t := 1.0
+		if weighted && maxWeight == 0 {
+			t = 0
+		} else if weighted {
+			t = math.Cbrt(float64(wuv) * float64(wuw) * float64(wvw) / (maxWeight * maxWeight * maxWeight))
+		}
+		sums[worker][u] += t
+		sums[worker][v] += t
+		sums[worker][w] += t
+	})
+
+	res := make(map[T]float64, n)
+	for i, node := range g.NodesList() {
+		d := float64(len(adj[i]))
+		if d < 2 {
+			res[node] = 0
+			continue
+		}
+		var t float64
+		for _, s := range sums {
+			t += s[i]
+		}
+		res[node] = 2 * t / (d * (d - 1))
+	}
+	return res, nil
+}
+
+// Returns the average of the local clustering coefficients of all nodes of
+// an undirected graph
+func (g *Graph[T, N]) AverageClustering(weighted bool) (float64, error) {
+	clustering, err := g.Clustering(weighted)
+	if err != nil || len(clustering) == 0 {
+		return 0, err
+	}
+	var sum float64
+	for _, c := range clustering {
+		sum += c
+	}
+	return sum / float64(len(clustering)), nil
+}
+
+// Returns the transitivity of an undirected graph, i.e. the fraction of
+// connected triples of nodes which form a triangle
+func (g *Graph[T, N]) Transitivity() (float64, error) {
+	triangles, err := g.Triangles()
+	if err != nil {
+		return 0, err
+	}
+
+	var closed, triples int
+	nodes := g.NodesList()
+	for i, edges := range g.simpleAdjacency() {
+		d := len(edges)
+		triples += d * (d - 1) / 2
+		closed += triangles[nodes[i]]
+	}
+	if triples == 0 {
+		return 0, nil
+	}
+	return float64(closed) / float64(triples), nil
+}
+
+// Returns the number of triangles of a directed graph for each of the seven
+// types of closed triads, keyed by their code in the triad census of Holland
+// and Leinhardt: "030T" (feed-forward loop), "030C" (cycle), "120D", "120U",
+// "120C", "210" and "300". Digits give the number of mutual, asymmetric and
+// null pairs of nodes.
+func (g *Graph[T, N]) DirectedTriangleMotifs() (map[string]int, error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	// Triangles of the underlying undirected graph are classified using the
+	// directions of their edges
+	n := len(g.nodes)
+	directed := make(map[edgeKey[int]]bool)
+	neighbors := make([]map[int]bool, n)
+	for i := range neighbors {
+		neighbors[i] = make(map[int]bool)
+	}
+	for src, edges := range g.edges {
+		u := g.nodes[src]
+		for _, e := range edges {
+			if v := g.nodes[e.Node]; u != v {
+				directed[edgeKey[int]{u, v}] = true
+				neighbors[u][v] = true
+				neighbors[v][u] = true
+			}
+		}
+	}
+	adj := make([][]indexedEdge[int], n)
+	for u, nbrs := range neighbors {
+		for v := range nbrs {
+			adj[u] = append(adj[u], indexedEdge[int]{to: v})
+		}
+	}
+
+	codes := []string{"030T", "030C", "120D", "120U", "120C", "210", "300"}
+	workers := numWorkers(0, n)
+	counts := make([]map[string]int, workers)
+	for i := range counts {
+		counts[i] = make(map[string]int)
+	}
+	eachTriangle(adj, workers, func(worker, u, v, w int, _, _, _ int) {
+		counts[worker][triadCode(directed, u, v, w)]++
+	})
+
+	res := make(map[string]int, len(codes))
+	for _, code := range codes {
+		for _, c := range counts {
+			res[code] += c[code]
+		}
+	}
+	return res, nil
+}
+
+// Returns the census code of a triad whose three pairs are connected
+func triadCode(directed map[edgeKey[int]]bool, a, b, c int) string {
+	nodes := [3]int{a, b, c}
+	mutual := 0
+	var outDegree [3]int
+	for i := range 3 {
+		for j := range 3 {
+			if i != j && directed[edgeKey[int]{nodes[i], nodes[j]}] {
+				outDegree[i]++
+			}
+		}
+	}
+	for i := range 3 {
+		for j := i + 1; j < 3; j++ {
+			if directed[edgeKey[int]{nodes[i], nodes[j]}] && directed[edgeKey[int]{nodes[j], nodes[i]}] {
+				mutual++
+			}
+		}
+	}
+
+	switch mutual {
+	case 3:
+		return "300"
+	case 2:
+		return "210"
+	case 1:
+		// The node outside of the mutual pair has either two outgoing, two
+		// incoming, or one incoming and one outgoing asymmetric edge
+		for i := range 3 {
+			j, k := nodes[(i+1)%3], nodes[(i+2)%3]
+			if directed[edgeKey[int]{j, k}] && directed[edgeKey[int]{k, j}] {
+				switch outDegree[i] {
+				case 2:
+					return "120D"
+				case 0:
+					return "120U"
+				}
+				return "120C"
+			}
+		}
+	}
+	if outDegree == [3]int{1, 1, 1} {
+		return "030C"
+	}
+	return "030T"
+}
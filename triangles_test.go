@@ -0,0 +1,161 @@
+package edsger
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestTriangles(t *testing.T) {
+	g := FullyConnectedGraph(4)
+
+	triangles, err := g.Triangles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for node, c := range triangles {
+		if c != 3 {
+			t.Fatalf("Expected 3 triangles for %d, got %d", node, c)
+		}
+	}
+
+	clustering, _ := g.Clustering(false)
+	assertScores(t, clustering, map[int]float64{0: 1, 1: 1, 2: 1, 3: 1})
+	if tr, _ := g.Transitivity(); tr != 1 {
+		t.Fatalf("Invalid transitivity: %v", tr)
+	}
+}
+
+func TestTrianglesKarateClub(t *testing.T) {
+	g := UnweightedKarateClubGraph()
+
+	// Reference values from networkx
+	triangles, err := g.Triangles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0
+	for _, c := range triangles {
+		total += c
+	}
+	if total != 3*45 || triangles[0] != 18 {
+		t.Fatalf("Invalid triangles: %d in total and %d for node 0", total/3, triangles[0])
+	}
+
+	clustering, _ := g.Clustering(false)
+	if math.Abs(clustering[0]-0.15) > 1e-9 {
+		t.Fatalf("Invalid clustering for node 0: %v", clustering[0])
+	}
+	if c, _ := g.AverageClustering(false); math.Abs(c-0.5706384782076823) > 1e-9 {
+		t.Fatalf("Invalid average clustering: %v", c)
+	}
+	if tr, _ := g.Transitivity(); math.Abs(tr-0.2556818181818182) > 1e-9 {
+		t.Fatalf("Invalid transitivity: %v", tr)
+	}
+}
+
+func TestTrianglesBruteForce(t *testing.T) {
+	for seed := range int64(10) {
+		g := RandomGraph(false, 30, 150, 1, 1, seed)
+
+		triangles, err := g.Triangles()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := make(map[int]int)
+		nodes := g.NodesList()
+		for i, u := range nodes {
+			for j, v := range nodes[:i] {
+				for _, w := range nodes[:j] {
+					_, uv := g.getEdge(u, v)
+					_, uw := g.getEdge(u, w)
+					_, vw := g.getEdge(v, w)
+					if uv && uw && vw {
+						expected[u]++
+						expected[v]++
+						expected[w]++
+					}
+				}
+			}
+		}
+		for _, node := range nodes {
+			if triangles[node] != expected[node] {
+				t.Fatalf("Invalid triangles for %d: expected %d, got %d", node, expected[node], triangles[node])
+			}
+		}
+	}
+}
+
+func TestWeightedClustering(t *testing.T) {
+	g := NewUndirectedGraph[int, int]()
+	for i := range 4 {
+		g.AddNode(i)
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 2)
+	g.AddEdge(2, 0, 4)
+	g.AddEdge(2, 3, 4)
+
+	clustering, err := g.Clustering(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each node of the triangle gets the geometric mean of 1/4, 2/4 and 4/4
+	assertScores(t, clustering, map[int]float64{0: 0.5, 1: 0.5, 2: 0.5 / 3, 3: 0})
+
+	if c, _ := g.AverageClustering(true); math.Abs(c-(0.5+0.5+0.5/3)/4) > 1e-9 {
+		t.Fatalf("Invalid average clustering: %v", c)
+	}
+
+	g.UpdateEdge(0, 1, -1)
+	if _, err := g.Clustering(true); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestTrianglesDirected(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	if _, err := g.Triangles(); !errors.Is(err, ErrDirected) {
+		t.Fatalf("Expected ErrDirected, got %v", err)
+	}
+	if _, err := g.Clustering(false); !errors.Is(err, ErrDirected) {
+		t.Fatalf("Expected ErrDirected, got %v", err)
+	}
+	if _, err := NewUndirectedGraph[int, int]().DirectedTriangleMotifs(); !errors.Is(err, ErrNotDirected) {
+		t.Fatalf("Expected ErrNotDirected, got %v", err)
+	}
+}
+
+func TestDirectedTriangleMotifs(t *testing.T) {
+	g := NewDirectedGraph[int, int]()
+	for i := range 21 {
+		g.AddNode(i)
+	}
+	// One triad of each type using nodes a, b and c
+	for i, edges := range [][][2]int{
+		{{0, 1}, {1, 2}, {0, 2}},                         // 030T
+		{{0, 1}, {1, 2}, {2, 0}},                         // 030C
+		{{0, 1}, {1, 0}, {2, 0}, {2, 1}},                 // 120D
+		{{0, 1}, {1, 0}, {0, 2}, {1, 2}},                 // 120U
+		{{0, 1}, {1, 0}, {0, 2}, {2, 1}},                 // 120C
+		{{0, 1}, {1, 0}, {1, 2}, {2, 1}, {0, 2}},         // 210
+		{{0, 1}, {1, 0}, {1, 2}, {2, 1}, {0, 2}, {2, 0}}, // 300
+	} {
+		for _, e := range edges {
+			g.AddEdge(3*i+e[0], 3*i+e[1], 1)
+		}
+	}
+
+	motifs, err := g.DirectedTriangleMotifs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, code := range []string{"030T", "030C", "120D", "120U", "120C", "210", "300"} {
+		if motifs[code] != 1 {
+			t.Fatalf("Expected one %s triad, got %d", code, motifs[code])
+		}
+	}
+	if len(motifs) != 7 {
+		t.Fatalf("Expected 7 triad types, got %v", motifs)
+	}
+}
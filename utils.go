@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -75,3 +78,64 @@ func MaxValue[N Number]() N {
 	}
 	panic(fmt.Sprintf("Unknown type: %T", v))
 }
+
+// Panics if err is not nil, otherwise returns v
+func must[V any](v V, err error) V {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Panics if err is not nil, otherwise returns a and b
+func must2[A, B any](a A, b B, err error) (A, B) {
+	if err != nil {
+		panic(err)
+	}
+	return a, b
+}
+
+// Calls fn on each item using at most the given number of parallel workers.
+// The index of the worker is passed to fn such that workers can accumulate
+// results without synchronization. Uses GOMAXPROCS workers if workers <= 0.
+func parallelEach[V any](items []V, workers int, fn func(worker int, item V)) {
+	workers = numWorkers(workers, len(items))
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := range workers {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1)) - 1
+				if i >= len(items) {
+					return
+				}
+				fn(w, items[i])
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Returns the number of workers used by parallelEach
+func numWorkers(workers, items int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return max(1, min(workers, items))
+}
+
+// Calls fn on consecutive ranges [lo, hi) covering [0, n) using at most the
+// given number of parallel workers
+func parallelRange(n, workers int, fn func(lo, hi int)) {
+	const chunk = 256
+	var starts []int
+	for lo := 0; lo < n; lo += chunk {
+		starts = append(starts, lo)
+	}
+	parallelEach(starts, workers, func(_ int, lo int) {
+		fn(lo, min(lo+chunk, n))
+	})
+}